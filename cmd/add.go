@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	"projgen/internal/generator"
+	"projgen/internal/ui"
+)
+
+// addCmd applies an addon or extra to an existing project.
+var addCmd = &cobra.Command{
+	Use:   "add <addon> [dir]",
+	Short: "Add an addon or extra to an existing project",
+	Long: "Applies an addon (e.g. tailwindcss, shadcn) or extra (e.g. docker-compose, github-actions) to the project\n" +
+		"in dir (default: the current directory), detecting the framework from .projgen.json or from package.json/go.mod.\n\n" +
+		"Available: " + strings.Join(generator.AddonNames(), ", "),
+	Args:      cobra.MatchAll(cobra.MinimumNArgs(1), cobra.MaximumNArgs(2)),
+	ValidArgs: generator.AddonNames(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		dir, err := projectDirArg(args[1:])
+		if err != nil {
+			return err
+		}
+		force, _ := cmd.Flags().GetBool("force")
+		noInstall, _ := cmd.Flags().GetBool("no-install")
+
+		aopts := generator.AddOptions{Force: force, Install: !noInstall}
+		// Only prompt on conflicts when attached to a terminal.
+		if isInteractive() {
+			aopts.Confirm = ui.ConfirmOverwrite
+		}
+
+		if err := generator.Add(cmd.Context(), dir, args[0], cfg, aopts); err != nil {
+			pterm.Error.Printfln("เพิ่ม %s ไม่สำเร็จ: %v", args[0], err)
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	addCmd.Flags().Bool("force", false, "overwrite existing files without prompting")
+	addCmd.Flags().Bool("no-install", false, "skip running install commands")
+	rootCmd.AddCommand(addCmd)
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	"projgen/internal/config"
+)
+
+// configCmd groups subcommands that read and write projgen configuration.
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and write projgen configuration",
+	Long: "Manage defaults stored in the user config (~/.projgen/config or $XDG_CONFIG_HOME/projgen/config)\n" +
+		"and the project config (" + config.ProjectConfigFile + ").\n" +
+		"Precedence: flags > env vars (" + config.EnvPrefix + "*) > project config > user config > built-in.",
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the effective value of a config key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		value, _, err := cfg.Get(args[0])
+		if err != nil {
+			pterm.Error.Println(err)
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), value)
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Store a config value in the user (or project) config file",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := config.UserConfigPath()
+		if project, _ := cmd.Flags().GetBool("project"); project {
+			path = config.ProjectConfigPath()
+		}
+		if path == "" {
+			err := fmt.Errorf("ไม่สามารถระบุตำแหน่งไฟล์ config ได้")
+			pterm.Error.Println(err)
+			return err
+		}
+		if err := config.SetValue(path, args[0], args[1]); err != nil {
+			pterm.Error.Printfln("บันทึกค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		pterm.Success.Printfln("ตั้งค่า %s = %s ใน %s", pterm.Cyan(args[0]), args[1], path)
+		return nil
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all config keys with their effective values and sources",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		tableData := pterm.TableData{
+			{pterm.LightMagenta("key"), pterm.LightMagenta("value"), pterm.LightMagenta("source"), pterm.LightMagenta("env")},
+		}
+		for _, key := range config.Keys() {
+			value, src, _ := cfg.Get(key)
+			tableData = append(tableData, []string{pterm.Cyan(key), value, string(src), config.EnvName(key)})
+		}
+		return pterm.DefaultTable.WithHasHeader().WithBoxed().WithData(tableData).Render()
+	},
+}
+
+func init() {
+	configSetCmd.Flags().Bool("project", false, "write to the project config ("+config.ProjectConfigFile+") instead of the user config")
+
+	configCmd.AddCommand(configGetCmd, configSetCmd, configListCmd)
+	rootCmd.AddCommand(configCmd)
+}
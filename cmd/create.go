@@ -2,26 +2,93 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
 	"strings"
 
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 
 	"projgen/internal/generator"
+	"projgen/internal/naming"
+	"projgen/internal/spec"
 	"projgen/internal/ui"
 )
 
 // createCmd defines the "create" subcommand which triggers the interactive setup wizard.
 var createCmd = &cobra.Command{
-	Use:   "create",
+	Use:   "create [dir]",
 	Short: "Create a new project via an interactive wizard",
-	Long:  "Launches an interactive prompt to choose language, framework, runtime, and features, then scaffolds the project.",
+	Long: "Launches an interactive prompt to choose language, framework, runtime, and features, then scaffolds the project.\n\n" +
+		"The project is created in ./<name> by default. Pass a directory (or --output) to generate elsewhere;\n" +
+		"\"projgen create .\" scaffolds in place and uses the current directory name as the project name.\n\n" +
+		"With --spec services.yaml, every service in the file is generated (into services/<name> or sibling\n" +
+		"directories) with non-colliding ports, plus a root docker-compose.yml and README index.",
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Use the command's context for cancellation and deadlines if provided.
 		var ctx context.Context = cmd.Context()
 
-		// 1) Trigger interactive prompt sequence in the UI layer.
-		choices, err := ui.RunWizard(ctx)
+		gopts, err := generateOptions(cmd)
+		if err != nil {
+			pterm.Error.Println(err.Error())
+			return err
+		}
+
+		// An explicit output directory also names the project after it.
+		output, _ := cmd.Flags().GetString("output")
+		if len(args) == 1 {
+			if output != "" {
+				err := errors.New("ระบุโฟลเดอร์ปลายทางได้ทางเดียว: อาร์กิวเมนต์ หรือ --output")
+				pterm.Error.Println(err.Error())
+				return err
+			}
+			output = args[0]
+		}
+
+		// A spec file generates several services without the wizard.
+		if specPath, _ := cmd.Flags().GetString("spec"); specPath != "" {
+			gopts.Output = output
+			return createFromSpec(cmd, specPath, gopts)
+		}
+		var name string
+		if output != "" {
+			dir, err := generator.CheckOutputDir(output, gopts.Merge)
+			if err != nil {
+				pterm.Error.Println(err.Error())
+				return err
+			}
+			gopts.Output, name = dir, filepath.Base(dir)
+			// Directory names are often not valid package names (e.g. "My App"); fall back to a suggestion.
+			if err := naming.Validate(name, naming.Filesystem, naming.Docker, naming.NPM, naming.GoModule); err != nil {
+				suggested := naming.Suggest(name)
+				pterm.Warning.Printfln("ชื่อโฟลเดอร์ %q ใช้เป็นชื่อโปรเจ็กต์ไม่ได้ ใช้ %q แทน", name, suggested)
+				name = suggested
+			}
+		}
+		if flagName, _ := cmd.Flags().GetString("name"); flagName != "" {
+			if err := naming.Validate(flagName, naming.Filesystem, naming.Docker); err != nil {
+				pterm.Error.Println(err.Error())
+				return err
+			}
+			name = flagName
+		}
+
+		// 1) Resolve layered configuration (flags > env > project > user > built-in).
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+
+		// Fail before the wizard if any catalog entry points to a template that cannot be found.
+		if err := generator.CheckTemplates(cfg.TemplateSources); err != nil {
+			pterm.Error.Println(err.Error())
+			return err
+		}
+
+		// 2) Trigger interactive prompt sequence in the UI layer.
+		choices, err := ui.RunWizard(ctx, cfg, name)
 		if err != nil {
 			// แสดงผลแบบเป็นมิตรและออกอย่างนุ่มนวล
 			if strings.Contains(err.Error(), "ยกเลิกโดยผู้ใช้") {
@@ -32,8 +99,8 @@ var createCmd = &cobra.Command{
 			return err
 		}
 
-		// 2) Pass collected data to the generator to scaffold the project.
-		if err := generator.Generate(ctx, choices); err != nil {
+		// 3) Pass collected data to the generator to scaffold the project.
+		if err := generator.Generate(ctx, choices, cfg, gopts); err != nil {
 			pterm.Error.Printfln("สร้างโปรเจ็กต์ไม่สำเร็จ: %v", err)
 			return err
 		}
@@ -42,7 +109,70 @@ var createCmd = &cobra.Command{
 	},
 }
 
+// createFromSpec generates every service listed in a spec file (see internal/spec) in one run.
+func createFromSpec(cmd *cobra.Command, path string, gopts generator.GenerateOptions) error {
+	s, err := spec.Load(path)
+	if err != nil {
+		pterm.Error.Println(err.Error())
+		return err
+	}
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+		return err
+	}
+	if err := generator.CheckTemplates(cfg.TemplateSources); err != nil {
+		pterm.Error.Println(err.Error())
+		return err
+	}
+	if err := generator.GenerateServices(cmd.Context(), s, cfg, gopts); err != nil {
+		pterm.Error.Printfln("สร้าง services ไม่สำเร็จ: %v", err)
+		return err
+	}
+	return nil
+}
+
+// generateOptions builds the merge/conflict settings from the create flags.
+// --force implies --merge with the overwrite policy; --on-conflict implies --merge.
+func generateOptions(cmd *cobra.Command) (generator.GenerateOptions, error) {
+	merge, _ := cmd.Flags().GetBool("merge")
+	force, _ := cmd.Flags().GetBool("force")
+	onConflict, _ := cmd.Flags().GetString("on-conflict")
+
+	var gopts generator.GenerateOptions
+	switch {
+	case force:
+		gopts.Merge, gopts.Conflict = true, generator.PolicyOverwrite
+	case onConflict != "":
+		policy, err := generator.ParseConflictPolicy(onConflict)
+		if err != nil {
+			return gopts, err
+		}
+		gopts.Merge, gopts.Conflict = true, policy
+	case merge:
+		gopts.Merge, gopts.Conflict = true, generator.PolicyPrompt
+	}
+	// Prompting needs a terminal; otherwise existing files are kept.
+	if gopts.Conflict == generator.PolicyPrompt {
+		if isInteractive() {
+			gopts.Resolve = ui.ResolveConflict
+		} else {
+			gopts.Conflict = generator.PolicySkip
+		}
+	}
+	return gopts, nil
+}
+
 func init() {
+	createCmd.Flags().String("name", "", "project name (skips the name prompt; validated for npm, Go, Docker and the filesystem)")
+	createCmd.Flags().StringP("output", "o", "", "directory to generate the project into (default ./<name>)")
+	createCmd.Flags().Bool("merge", false, "allow generating into a non-empty directory, prompting on file conflicts")
+	createCmd.Flags().Bool("force", false, "allow generating into a non-empty directory, overwriting conflicting files")
+	createCmd.Flags().String("license", "", "license for the generated LICENSE file and package metadata: MIT, Apache-2.0, BSD-3-Clause, GPL-3.0-only, GPL-3.0-or-later or proprietary (skips the license prompt)")
+	createCmd.Flags().Bool("no-git", false, "do not initialize a git repository (same as --set git.init=false)")
+	createCmd.Flags().String("git-remote", "", "URL to add as the origin remote of the new repository (same as --set git.remote=<url>)")
+	createCmd.Flags().String("spec", "", "YAML file listing several services to generate in one run (skips the wizard)")
+	createCmd.Flags().String("on-conflict", "", "conflict policy for existing files: skip, overwrite, keep-both or prompt (implies --merge)")
 	// Register the create subcommand under the root command.
 	rootCmd.AddCommand(createCmd)
 }
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	"projgen/internal/generator"
+)
+
+// removeCmd undoes an addon or extra in an existing project.
+var removeCmd = &cobra.Command{
+	Use:   "remove <addon> [dir]",
+	Short: "Remove an addon or extra from an existing project",
+	Long: "Removes the files an addon or extra created in dir (default: the current directory) if they are\n" +
+		"unmodified (checked against .projgen.json), uninstalls its packages and reports anything that could\n" +
+		"not be removed safely.\n\n" +
+		"Available: " + strings.Join(generator.AddonNames(), ", "),
+	Args:      cobra.MatchAll(cobra.MinimumNArgs(1), cobra.MaximumNArgs(2)),
+	ValidArgs: generator.AddonNames(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		dir, err := projectDirArg(args[1:])
+		if err != nil {
+			return err
+		}
+		force, _ := cmd.Flags().GetBool("force")
+		noInstall, _ := cmd.Flags().GetBool("no-install")
+
+		res, err := generator.Remove(cmd.Context(), dir, args[0], cfg, generator.RemoveOptions{Force: force, Install: !noInstall})
+		if res != nil {
+			generator.PrintRemoveResult(args[0], res)
+		}
+		if err != nil {
+			pterm.Error.Printfln("ถอด %s ไม่สำเร็จ: %v", args[0], err)
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	removeCmd.Flags().Bool("force", false, "remove files even if they were modified")
+	removeCmd.Flags().Bool("no-install", false, "print the uninstall command instead of running it")
+	rootCmd.AddCommand(removeCmd)
+}
@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"projgen/internal/config"
+	"projgen/internal/ui"
 )
 
 // rootCmd is the base command for projgen.
@@ -12,6 +18,7 @@ var rootCmd = &cobra.Command{
 	// Keep usage quiet on errors; subcommands will provide context.
 	SilenceUsage:  true,
 	SilenceErrors: true,
+	Version:       config.Version,
 }
 
 // Execute runs the root command.
@@ -19,8 +26,42 @@ func Execute() error {
 	return rootCmd.Execute()
 }
 
+// loadConfig resolves the layered configuration, applying --set overrides on top,
+// and sets the UI language from it.
+func loadConfig(cmd *cobra.Command) (*config.Config, error) {
+	pairs, err := cmd.Flags().GetStringArray("set")
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := config.ParseOverrides(pairs)
+	if err != nil {
+		return nil, err
+	}
+	// Shorthand flags such as create --license are the same as --set license=<value>.
+	for flag, key := range map[string]string{"license": "license", "git-remote": "git.remote"} {
+		if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
+			overrides[key] = f.Value.String()
+		}
+	}
+	if noGit, _ := cmd.Flags().GetBool("no-git"); noGit {
+		overrides["git.init"] = "false"
+	}
+	cfg, err := config.Load(overrides)
+	if err != nil {
+		return nil, err
+	}
+	// Prompts outside the wizard (e.g. overwrite confirmations) follow the configured language too.
+	ui.SetLang(cfg.Lang)
+	return cfg, nil
+}
+
+// isInteractive reports whether stdin is attached to a terminal.
+func isInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
 func init() {
-	// Global persistent flags can be defined here in later phases.
-	// Example: rootCmd.PersistentFlags().BoolP("yes", "y", false, "non-interactive yes to all prompts")
+	// Config overrides take precedence over env vars and config files.
+	rootCmd.PersistentFlags().StringArray("set", nil, "override a config value for this run (key=value, repeatable)")
 }
 
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	"projgen/internal/generator"
+)
+
+// statusCmd reports how far a generated project has drifted from its originating template.
+var statusCmd = &cobra.Command{
+	Use:   "status [dir]",
+	Short: "Report drift between a generated project and its originating template",
+	Long: "Compares the project's files with the hashes recorded in .projgen.json and with what the recorded\n" +
+		"template and options would produce today, listing user-modified, deleted and outdated files.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := projectDirArg(args)
+		if err != nil {
+			return err
+		}
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+
+		report, err := generator.Status(cmd.Context(), dir, cfg)
+		if err != nil {
+			pterm.Error.Printfln("ตรวจสอบสถานะไม่สำเร็จ: %v", err)
+			return err
+		}
+
+		if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				return err
+			}
+		} else {
+			generator.PrintStatus(report)
+		}
+
+		if exitCode, _ := cmd.Flags().GetBool("exit-code"); exitCode && !report.Clean() {
+			return errors.New("project has drifted from its template")
+		}
+		return nil
+	},
+}
+
+func init() {
+	statusCmd.Flags().Bool("json", false, "print the report as JSON")
+	statusCmd.Flags().Bool("exit-code", false, "exit with status 1 when the project has drifted")
+	rootCmd.AddCommand(statusCmd)
+}
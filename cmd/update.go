@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+
+	"projgen/internal/generator"
+)
+
+// updateCmd re-applies the current template version to an existing generated project.
+var updateCmd = &cobra.Command{
+	Use:   "update [dir]",
+	Short: "Re-apply newer templates to an existing project via three-way merge",
+	Long: "Regenerates the project from the template version recorded in .projgen.json and from the current template,\n" +
+		"then three-way merges template changes into the working tree. Clashing user edits get conflict markers\n" +
+		"(or a .rej file when no merge base is available).",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := projectDirArg(args)
+		if err != nil {
+			return err
+		}
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			pterm.Error.Printfln("อ่านค่า config ไม่สำเร็จ: %v", err)
+			return err
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		changes, err := generator.Update(cmd.Context(), dir, cfg, generator.UpdateOptions{DryRun: dryRun})
+		if err != nil {
+			pterm.Error.Printfln("อัปเดตโปรเจ็กต์ไม่สำเร็จ: %v", err)
+			return err
+		}
+
+		if dryRun {
+			pterm.Info.Println("โหมด dry-run: ยังไม่มีการเขียนไฟล์")
+		}
+		generator.PrintChanges(changes, dryRun)
+		for _, c := range changes {
+			if c.Action == generator.ActionConflict {
+				pterm.Warning.Println("มีไฟล์ที่ชนกัน กรุณาตรวจสอบ conflict markers หรือไฟล์ .rej")
+				break
+			}
+		}
+		return nil
+	},
+}
+
+// projectDirArg returns the project directory from an optional positional argument.
+func projectDirArg(args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return os.Getwd()
+}
+
+func init() {
+	updateCmd.Flags().Bool("dry-run", false, "show the diff without writing any files")
+	rootCmd.AddCommand(updateCmd)
+}
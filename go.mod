@@ -7,6 +7,8 @@ require (
 	github.com/briandowns/spinner v1.23.0
 	github.com/pterm/pterm v0.12.79
 	github.com/spf13/cobra v1.8.0
+	golang.org/x/term v0.16.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -28,6 +30,5 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/xo/terminfo v0.0.0-20220910002029-abceb7e1c41e // indirect
 	golang.org/x/sys v0.25.0 // indirect
-	golang.org/x/term v0.16.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 )
@@ -2,3 +2,468 @@ package config
 
 // Global configuration, constants, and well-known paths.
 // Reads ~/.projgen/config and project-level overrides.
+//
+// ลำดับความสำคัญของค่า (สูงไปต่ำ): flags > env vars > project config > user config > git config > built-in
+// git config ให้ค่าเริ่มต้นของ author.name และ author.email (user.name, user.email)
+// รูปแบบไฟล์เป็น key = value ทีละบรรทัด บรรทัดที่ขึ้นต้นด้วย # คือคอมเมนต์
+// ค่าที่เป็นรายการ (เช่น default-extras) คั่นด้วยเครื่องหมายจุลภาค
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"projgen/internal/license"
+)
+
+// Version เวอร์ชันของ projgen (กำหนดตอน build ด้วย -ldflags "-X projgen/internal/config.Version=...")
+var Version = "dev"
+
+// ProjectConfigFile ชื่อไฟล์ config ระดับโปรเจ็กต์ (ค้นหาในโฟลเดอร์ปัจจุบัน)
+const ProjectConfigFile = ".projgenrc"
+
+// EnvPrefix prefix ของ environment variables เช่น PROJGEN_PACKAGE_MANAGER
+const EnvPrefix = "PROJGEN_"
+
+// Source ที่มาของค่าแต่ละตัวใน Config
+type Source string
+
+const (
+	SourceDefault Source = "built-in"
+	SourceGit     Source = "git config"
+	SourceUser    Source = "user"
+	SourceProject Source = "project"
+	SourceEnv     Source = "env"
+	SourceFlag    Source = "flag"
+)
+
+// Config ค่าตั้งต้นของผู้ใช้ที่ใช้ประกอบการสร้างโปรเจ็กต์
+type Config struct {
+	PackageManager  string   // npm, pnpm, yarn, bun
+	AuthorName      string   // ชื่อผู้เขียน
+	AuthorEmail     string   // อีเมลผู้เขียน
+	License         string   // SPDX identifier เช่น MIT
+	DefaultExtras   []string // extras ที่เลือกไว้ล่วงหน้าในวิซาร์ด (ใช้ Name จาก GetExtras)
+	CIProvider      string   // github, gitlab, none
+	TemplateSources []string // โฟลเดอร์เพิ่มเติมสำหรับค้นหาเทมเพลต
+	Lang            string   // ภาษาของ UI: th, en
+	GoModulePrefix  string   // prefix ของ Go module path เช่น github.com/our-org
+	GitInit         bool     // สร้าง git repository พร้อม commit แรกเป็นค่าเริ่มต้นในวิซาร์ด
+	GitBranch       string   // ชื่อ branch เริ่มต้นของ repository ที่สร้าง
+	GitCommitMsg    string   // ข้อความของ commit แรก
+	GitRemote       string   // URL ของ remote origin (มักมาจาก create --git-remote)
+	GitRemotePrefix string   // prefix ของ remote URL เช่น git@github.com:our-org (remote = <prefix>/<name>.git)
+
+	sources map[string]Source
+}
+
+// configKey นิยาม key ที่รองรับพร้อมวิธีอ่าน/เขียนค่าใน Config
+type configKey struct {
+	Name        string
+	Description string
+	Allowed     []string // ค่าที่อนุญาต (ว่าง = ไม่จำกัด)
+	get         func(c *Config) string
+	set         func(c *Config, v string)
+}
+
+var configKeys = []configKey{
+	{
+		Name:        "package-manager",
+		Description: "package manager สำหรับโปรเจ็กต์ Node",
+		Allowed:     []string{"npm", "pnpm", "yarn", "bun"},
+		get:         func(c *Config) string { return c.PackageManager },
+		set:         func(c *Config, v string) { c.PackageManager = v },
+	},
+	{
+		Name:        "author.name",
+		Description: "ชื่อผู้เขียน",
+		get:         func(c *Config) string { return c.AuthorName },
+		set:         func(c *Config, v string) { c.AuthorName = v },
+	},
+	{
+		Name:        "author.email",
+		Description: "อีเมลผู้เขียน",
+		get:         func(c *Config) string { return c.AuthorEmail },
+		set:         func(c *Config, v string) { c.AuthorEmail = v },
+	},
+	{
+		Name:        "license",
+		Description: "license เริ่มต้น (SPDX identifier หรือ proprietary)",
+		get:         func(c *Config) string { return c.License },
+		set:         func(c *Config, v string) { c.License = license.Normalize(v) },
+	},
+	{
+		Name:        "default-extras",
+		Description: "ตัวเลือกเสริมที่เลือกไว้ล่วงหน้า (คั่นด้วย ,)",
+		get:         func(c *Config) string { return strings.Join(c.DefaultExtras, ",") },
+		set:         func(c *Config, v string) { c.DefaultExtras = splitList(v) },
+	},
+	{
+		Name:        "ci-provider",
+		Description: "ผู้ให้บริการ CI ที่วิซาร์ดเสนอ workflow ให้ (none = ไม่เสนอ)",
+		Allowed:     []string{"github", "gitlab", "none"},
+		get:         func(c *Config) string { return c.CIProvider },
+		set:         func(c *Config, v string) { c.CIProvider = v },
+	},
+	{
+		Name:        "template-sources",
+		Description: "โฟลเดอร์เพิ่มเติมสำหรับค้นหาเทมเพลต (คั่นด้วย ,)",
+		get:         func(c *Config) string { return strings.Join(c.TemplateSources, ",") },
+		set:         func(c *Config, v string) { c.TemplateSources = splitList(v) },
+	},
+	{
+		Name:        "go-module-prefix",
+		Description: "prefix ของ Go module path เช่น github.com/our-org",
+		get:         func(c *Config) string { return c.GoModulePrefix },
+		set:         func(c *Config, v string) { c.GoModulePrefix = strings.TrimSuffix(v, "/") },
+	},
+	{
+		Name:        "git.init",
+		Description: "สร้าง git repository พร้อม commit แรก (ค่าเริ่มต้นในวิซาร์ด)",
+		Allowed:     []string{"true", "false"},
+		get:         func(c *Config) string { return strconv.FormatBool(c.GitInit) },
+		set:         func(c *Config, v string) { c.GitInit = v == "true" },
+	},
+	{
+		Name:        "git.default-branch",
+		Description: "ชื่อ branch เริ่มต้นของ repository ที่สร้าง",
+		get:         func(c *Config) string { return c.GitBranch },
+		set:         func(c *Config, v string) { c.GitBranch = v },
+	},
+	{
+		Name:        "git.commit-message",
+		Description: "ข้อความของ commit แรก",
+		get:         func(c *Config) string { return c.GitCommitMsg },
+		set:         func(c *Config, v string) { c.GitCommitMsg = v },
+	},
+	{
+		Name:        "git.remote",
+		Description: "URL ของ remote origin ของโปรเจ็กต์ที่สร้าง (มีผลก่อน git.remote-prefix)",
+		get:         func(c *Config) string { return c.GitRemote },
+		set:         func(c *Config, v string) { c.GitRemote = strings.TrimSpace(v) },
+	},
+	{
+		Name:        "git.remote-prefix",
+		Description: "prefix ของ remote origin เช่น git@github.com:our-org (ว่าง = ไม่เพิ่ม remote)",
+		get:         func(c *Config) string { return c.GitRemotePrefix },
+		set:         func(c *Config, v string) { c.GitRemotePrefix = strings.TrimSuffix(v, "/") },
+	},
+	{
+		Name:        "lang",
+		Description: "ภาษาของข้อความในวิซาร์ด",
+		Allowed:     []string{"th", "en"},
+		get:         func(c *Config) string { return c.Lang },
+		set:         func(c *Config, v string) { c.Lang = v },
+	},
+}
+
+// ciExtras ชื่อ extra ของ CI แต่ละผู้ให้บริการ (ใช้ Name จาก GetExtras)
+var ciExtras = map[string]string{
+	"github": "github-actions",
+	"gitlab": "gitlab-ci",
+}
+
+// CIExtra คืนชื่อ extra ของ CI ตาม ci-provider (สตริงว่างเมื่อเป็น none)
+func (c *Config) CIExtra() string {
+	return ciExtras[c.CIProvider]
+}
+
+// IsCIExtra ตรวจว่า extra เป็น workflow ของ CI หรือไม่
+func IsCIExtra(name string) bool {
+	for _, ex := range ciExtras {
+		if ex == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Defaults คืนค่า built-in ของ Config
+func Defaults() *Config {
+	c := &Config{sources: map[string]Source{}}
+	c.apply(map[string]string{
+		"package-manager":    "npm",
+		"license":            "MIT",
+		"ci-provider":        "github",
+		"lang":               "th",
+		"git.init":           "true",
+		"git.default-branch": "main",
+		"git.commit-message": "Initial commit from projgen",
+	}, SourceDefault)
+	return c
+}
+
+// Load อ่าน config ทุกชั้นตามลำดับความสำคัญ
+// overrides คือค่าที่มาจาก flags ซึ่งมีความสำคัญสูงสุด
+func Load(overrides map[string]string) (*Config, error) {
+	c := Defaults()
+	c.apply(gitValues(), SourceGit)
+
+	layers := []struct {
+		path   string
+		source Source
+	}{
+		{UserConfigPath(), SourceUser},
+		{ProjectConfigPath(), SourceProject},
+	}
+	for _, l := range layers {
+		if l.path == "" {
+			continue
+		}
+		values, err := ReadFile(l.path)
+		if err != nil {
+			return nil, err
+		}
+		if err := validate(values); err != nil {
+			return nil, fmt.Errorf("%s: %w", l.path, err)
+		}
+		c.apply(values, l.source)
+	}
+
+	env := envValues()
+	if err := validate(env); err != nil {
+		return nil, fmt.Errorf("environment: %w", err)
+	}
+	c.apply(env, SourceEnv)
+
+	if err := validate(overrides); err != nil {
+		return nil, err
+	}
+	c.apply(overrides, SourceFlag)
+	return c, nil
+}
+
+// Get คืนค่าของ key พร้อมที่มา
+func (c *Config) Get(key string) (string, Source, error) {
+	k, ok := lookupKey(key)
+	if !ok {
+		return "", "", unknownKeyError(key)
+	}
+	src := c.sources[k.Name]
+	if src == "" {
+		src = SourceDefault
+	}
+	return k.get(c), src, nil
+}
+
+// Keys คืนชื่อ key ทั้งหมดที่รองรับ เรียงตามที่นิยามไว้
+func Keys() []string {
+	out := make([]string, len(configKeys))
+	for i, k := range configKeys {
+		out[i] = k.Name
+	}
+	return out
+}
+
+// Describe คืนคำอธิบายของ key
+func Describe(key string) string {
+	if k, ok := lookupKey(key); ok {
+		return k.Description
+	}
+	return ""
+}
+
+// UserConfigPath คืน path ของ config ระดับผู้ใช้
+// ใช้ ~/.projgen/config หากมีอยู่แล้ว มิฉะนั้นใช้ $XDG_CONFIG_HOME/projgen/config เมื่อกำหนดไว้
+func UserConfigPath() string {
+	home, _ := os.UserHomeDir()
+	legacy := ""
+	if home != "" {
+		legacy = filepath.Join(home, ".projgen", "config")
+		if _, err := os.Stat(legacy); err == nil {
+			return legacy
+		}
+	}
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "projgen", "config")
+	}
+	return legacy
+}
+
+// ProjectConfigPath คืน path ของ config ระดับโปรเจ็กต์ในโฟลเดอร์ปัจจุบัน
+func ProjectConfigPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(wd, ProjectConfigFile)
+}
+
+// ReadFile อ่านไฟล์ config แบบ key = value (ไม่มีไฟล์ถือว่าว่าง)
+func ReadFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := map[string]string{}
+	sc := bufio.NewScanner(f)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: รูปแบบไม่ถูกต้อง (ต้องเป็น key = value)", path, lineNo)
+		}
+		values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return values, sc.Err()
+}
+
+// SetValue บันทึกค่า key ลงไฟล์ config โดยคงบรรทัดอื่นไว้ตามเดิม
+func SetValue(path, key, value string) error {
+	k, ok := lookupKey(key)
+	if !ok {
+		return unknownKeyError(key)
+	}
+	if err := validate(map[string]string{k.Name: value}); err != nil {
+		return err
+	}
+
+	var lines []string
+	if b, err := os.ReadFile(path); err == nil {
+		lines = strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	entry := fmt.Sprintf("%s = %s", k.Name, value)
+	replaced := false
+	for i, line := range lines {
+		name, _, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if ok && !strings.HasPrefix(strings.TrimSpace(line), "#") && strings.TrimSpace(name) == k.Name {
+			lines[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		lines = append(lines, entry)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644)
+}
+
+// ParseOverrides แปลงค่าแบบ key=value (เช่นจาก flag --set) เป็น map
+func ParseOverrides(pairs []string) (map[string]string, error) {
+	out := map[string]string{}
+	for _, p := range pairs {
+		key, value, ok := strings.Cut(p, "=")
+		if !ok {
+			return nil, fmt.Errorf("รูปแบบไม่ถูกต้อง %q (ต้องเป็น key=value)", p)
+		}
+		out[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return out, nil
+}
+
+// ภายใน
+
+func (c *Config) apply(values map[string]string, src Source) {
+	for name, v := range values {
+		k, ok := lookupKey(name)
+		if !ok {
+			continue
+		}
+		k.set(c, v)
+		c.sources[k.Name] = src
+	}
+}
+
+func validate(values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		k, ok := lookupKey(name)
+		if !ok {
+			return unknownKeyError(name)
+		}
+		v := values[name]
+		if len(k.Allowed) > 0 && v != "" && !contains(k.Allowed, v) {
+			return fmt.Errorf("ค่า %q ไม่รองรับสำหรับ %s (รองรับ: %s)", v, k.Name, strings.Join(k.Allowed, ", "))
+		}
+	}
+	return nil
+}
+
+func envValues() map[string]string {
+	out := map[string]string{}
+	for _, k := range configKeys {
+		if v, ok := os.LookupEnv(EnvName(k.Name)); ok {
+			out[k.Name] = v
+		}
+	}
+	return out
+}
+
+// gitValues อ่านชื่อและอีเมลจาก git config ของผู้ใช้ (ไม่มี git หรือไม่ได้ตั้งค่า = ว่าง)
+func gitValues() map[string]string {
+	values := map[string]string{}
+	if _, err := exec.LookPath("git"); err != nil {
+		return values
+	}
+	for key, gitKey := range map[string]string{"author.name": "user.name", "author.email": "user.email"} {
+		out, err := exec.Command("git", "config", "--get", gitKey).Output()
+		if v := strings.TrimSpace(string(out)); err == nil && v != "" {
+			values[key] = v
+		}
+	}
+	return values
+}
+
+// EnvName คืนชื่อ environment variable ของ key เช่น author.name -> PROJGEN_AUTHOR_NAME
+func EnvName(key string) string {
+	r := strings.NewReplacer(".", "_", "-", "_")
+	return EnvPrefix + strings.ToUpper(r.Replace(key))
+}
+
+func lookupKey(name string) (configKey, bool) {
+	for _, k := range configKeys {
+		if k.Name == name {
+			return k, true
+		}
+	}
+	return configKey{}, false
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("ไม่รู้จัก config key %q (รองรับ: %s)", key, strings.Join(Keys(), ", "))
+}
+
+func splitList(v string) []string {
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		if p := strings.TrimSpace(part); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
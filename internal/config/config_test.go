@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ค่าของ key เดียวกันจากหลายชั้นต้องเลือกตามลำดับ flags > env > project > user > git config > built-in
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name                          string
+		git, user, project, env, flag string // author.name ในแต่ละชั้น (ว่าง = ไม่กำหนด)
+		want                          string
+		wantSrc                       Source
+	}{
+		{name: "built-in", want: "", wantSrc: SourceDefault},
+		{name: "git config", git: "git", want: "git", wantSrc: SourceGit},
+		{name: "user over git", git: "git", user: "user", want: "user", wantSrc: SourceUser},
+		{name: "project over user", git: "git", user: "user", project: "project", want: "project", wantSrc: SourceProject},
+		{name: "env over project", user: "user", project: "project", env: "env", want: "env", wantSrc: SourceEnv},
+		{name: "flag over env", git: "git", user: "user", project: "project", env: "env", flag: "flag", want: "flag", wantSrc: SourceFlag},
+		{name: "flag over git", git: "git", flag: "flag", want: "flag", wantSrc: SourceFlag},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home, xdg, project := t.TempDir(), t.TempDir(), t.TempDir()
+			t.Setenv("HOME", home)
+			t.Setenv("XDG_CONFIG_HOME", xdg)
+			t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+			t.Chdir(project)
+
+			gitConfig := filepath.Join(home, "gitconfig")
+			gitContent := ""
+			if tt.git != "" {
+				gitContent = "[user]\n\tname = " + tt.git + "\n"
+			}
+			writeFile(t, gitConfig, gitContent)
+			t.Setenv("GIT_CONFIG_GLOBAL", gitConfig)
+			if tt.user != "" {
+				writeFile(t, filepath.Join(xdg, "projgen", "config"), "author.name = "+tt.user+"\n")
+			}
+			if tt.project != "" {
+				writeFile(t, filepath.Join(project, ProjectConfigFile), "author.name = "+tt.project+"\n")
+			}
+			if tt.env != "" {
+				t.Setenv(EnvName("author.name"), tt.env)
+			}
+			overrides := map[string]string{}
+			if tt.flag != "" {
+				overrides["author.name"] = tt.flag
+			}
+
+			c, err := Load(overrides)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			got, src, err := c.Get("author.name")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || src != tt.wantSrc {
+				t.Errorf("author.name = %q (%s), want %q (%s)", got, src, tt.want, tt.wantSrc)
+			}
+			// key ที่ไม่มีชั้นใดกำหนดต้องคงค่า built-in
+			if v, src, _ := c.Get("ci-provider"); v != "github" || src != SourceDefault {
+				t.Errorf("ci-provider = %q (%s), want github (%s)", v, src, SourceDefault)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,6 +3,8 @@ package config
 // frameworks.go
 // กำหนด mapping ของ frameworks, templates, และคำสั่งที่ใช้สร้าง
 
+import "strings"
+
 // ProjectType ประเภทของโปรเจค
 type ProjectType string
 
@@ -10,8 +12,35 @@ const (
 	Frontend  ProjectType = "Frontend"
 	Backend   ProjectType = "Backend"
 	Fullstack ProjectType = "Fullstack"
+	Library   ProjectType = "Library"
+	Mobile    ProjectType = "Mobile"
+	Desktop   ProjectType = "Desktop"
 )
 
+// ProjectTypes ประเภทโปรเจคทั้งหมดตามลำดับที่แสดงในวิซาร์ด
+func ProjectTypes() []ProjectType {
+	return []ProjectType{Frontend, Backend, Fullstack, Library, Mobile, Desktop}
+}
+
+// FrameworksFor คืน frameworks ของประเภทโปรเจค
+func FrameworksFor(pt ProjectType) []FrameworkOption {
+	switch pt {
+	case Frontend:
+		return GetFrontendFrameworks()
+	case Backend:
+		return GetBackendFrameworks()
+	case Fullstack:
+		return GetFullstackFrameworks()
+	case Library:
+		return GetLibraryFrameworks()
+	case Mobile:
+		return GetMobileFrameworks()
+	case Desktop:
+		return GetDesktopFrameworks()
+	}
+	return nil
+}
+
 // FrameworkOption ตัวเลือก framework พร้อม metadata
 type FrameworkOption struct {
 	Name           string   // ชื่อ framework
@@ -24,6 +53,176 @@ type FrameworkOption struct {
 	BuildCmd       string   // คำสั่ง build (ถ้ามี)
 	Description    string   // คำอธิบาย
 	SupportedAddons []string // addons ที่รองรับ เช่น tailwind, prisma
+	MainPackage    string   // Go main package (ว่าง = ".") ใช้ใน Dockerfile
+	Port           int      // พอร์ตเริ่มต้น (0 = ตามภาษา: Go 8080, อื่น ๆ 3000, -1 = ไม่ใช่ server เช่น CLI)
+	Variants       []Variant // รูปแบบโครงสร้างโปรเจ็กต์ที่เลือกได้ (ตัวแรกเป็นค่าเริ่มต้น)
+	MinVersion     string   // เวอร์ชันขั้นต่ำของรันไทม์ (เช่น "3.10" สำหรับ python) ตรวจก่อนสร้างโปรเจ็กต์
+	ServeCmd       string   // คำสั่งรันแบบ production ใช้เป็น CMD ใน Dockerfile (ว่าง = ตามภาษา)
+	Toolchains     []string // เครื่องมือเพิ่มเติมที่ต้องมีบนเครื่องนอกจากรันไทม์ (เช่น cargo สำหรับ Tauri)
+	FrontendBase   bool     // เรนเดอร์เทมเพลต Vite ที่เลือกเป็นฐานก่อน แล้ววางไฟล์ของเทมเพลตนี้ทับ (เช่น Tauri)
+}
+
+// Variant รูปแบบโครงสร้างโปรเจ็กต์ทางเลือก ไฟล์อยู่ใน <TemplatePath>/_variants/<Name>/ และถูกวางทับไฟล์หลักของเทมเพลต
+// __name__ ใน path และคำสั่งจะถูกแทนด้วยชื่อโปรเจ็กต์แบบ kebab-case
+// variant ที่ระบุ Runtime เป็นตัวเลือกของรันไทม์ (เช่น Hono บน Bun/Deno/Node) และเลือกผ่านคำถามเรื่องรันไทม์แทนโครงสร้าง
+type Variant struct {
+	Name        string
+	DisplayName string
+	Description string
+	Runtime     string // ทับ FrameworkOption.Runtime
+	MainPackage string // ทับ FrameworkOption.MainPackage
+	InstallCmd  string // ทับ FrameworkOption.InstallCmd
+	StartCmd    string // ทับ FrameworkOption.StartCmd
+	BuildCmd    string // ทับ FrameworkOption.BuildCmd
+}
+
+// GoLayouts รูปแบบโครงสร้างโปรเจ็กต์ Go ที่เทมเพลต Go ใช้ร่วมกัน
+func GoLayouts() []Variant {
+	return []Variant{
+		{
+			Name:        "flat",
+			DisplayName: "Flat (main.go ที่ root)",
+			Description: "เหมาะกับ service ขนาดเล็ก ไฟล์ทั้งหมดอยู่ใน package main",
+			MainPackage: ".",
+			StartCmd:    "go run .",
+			BuildCmd:    "go build -o app .",
+		},
+		{
+			Name:        "standard",
+			DisplayName: "Standard (cmd/<name> + internal/)",
+			Description: "แยก entrypoint ไว้ที่ cmd/<name> และโค้ดแอปไว้ใน internal/",
+			MainPackage: "./cmd/__name__",
+			StartCmd:    "go run ./cmd/__name__",
+			BuildCmd:    "go build -o app ./cmd/__name__",
+		},
+	}
+}
+
+// JavaBuildTools เครื่องมือ build ของเทมเพลต Spring Boot (ใช้ wrapper ที่สร้างตอนติดตั้ง)
+func JavaBuildTools() []Variant {
+	return []Variant{
+		{
+			Name:        "gradle",
+			DisplayName: "Gradle (Kotlin DSL)",
+			Description: "build.gradle.kts + settings.gradle.kts และ Gradle wrapper (gradlew)",
+			InstallCmd:  "gradle wrapper",
+			StartCmd:    "./gradlew bootRun",
+			BuildCmd:    "./gradlew bootJar",
+		},
+		{
+			Name:        "maven",
+			DisplayName: "Maven",
+			Description: "pom.xml และ Maven wrapper (mvnw)",
+			InstallCmd:  "mvn -N wrapper:wrapper",
+			StartCmd:    "./mvnw spring-boot:run",
+			BuildCmd:    "./mvnw package",
+		},
+	}
+}
+
+// LibraryBundlers เครื่องมือ build ของเทมเพลต npm library ไฟล์ config อยู่ใน _variants/<name>/
+func LibraryBundlers() []Variant {
+	return []Variant{
+		{
+			Name:        "tsup",
+			DisplayName: "tsup",
+			Description: "build ESM + CJS และไฟล์ .d.ts ด้วย tsup (esbuild)",
+		},
+		{
+			Name:        "vite",
+			DisplayName: "Vite library mode",
+			Description: "build.lib ของ Vite และ vite-plugin-dts สำหรับไฟล์ .d.ts",
+		},
+	}
+}
+
+// HonoRuntimes รันไทม์ที่เทมเพลต Hono รองรับ ไฟล์เฉพาะรันไทม์อยู่ใน _variants/<runtime>/
+func HonoRuntimes() []Variant {
+	return []Variant{
+		{
+			Name:        "bun",
+			DisplayName: "Bun",
+			Description: "Bun.serve ผ่าน export default, bunfig.toml และ bun test",
+			Runtime:     "bun",
+			InstallCmd:  "bun install",
+			StartCmd:    "bun run dev",
+		},
+		{
+			Name:        "deno",
+			DisplayName: "Deno",
+			Description: "Deno.serve, deno.json tasks และ import จาก JSR",
+			Runtime:     "deno",
+			InstallCmd:  "deno install",
+			StartCmd:    "deno task dev",
+		},
+		{
+			Name:        "node",
+			DisplayName: "Node.js",
+			Description: "@hono/node-server รันด้วย tsx และ node:test",
+			Runtime:     "node",
+			InstallCmd:  "npm install",
+			StartCmd:    "npm run dev",
+		},
+	}
+}
+
+// MonorepoName ชื่อของ framework ที่รวมเทมเพลต frontend และ backend ไว้ใน repository เดียว
+const MonorepoName = "monorepo"
+
+// WorkspaceTools เครื่องมือจัดการ workspace ของ monorepo ไฟล์เฉพาะเครื่องมืออยู่ใน _variants/<name>/
+func WorkspaceTools() []Variant {
+	return []Variant{
+		{
+			Name:        "npm",
+			DisplayName: "npm workspaces",
+			Description: "workspaces ใน package.json รันทุกแอปพร้อมกันด้วย concurrently",
+			InstallCmd:  "npm install",
+			StartCmd:    "npm run dev",
+		},
+		{
+			Name:        "pnpm",
+			DisplayName: "pnpm workspaces",
+			Description: "pnpm-workspace.yaml และ pnpm -r สำหรับ build/test",
+			InstallCmd:  "pnpm install",
+			StartCmd:    "pnpm dev",
+		},
+		{
+			Name:        "turborepo",
+			DisplayName: "Turborepo",
+			Description: "turbo.json พร้อม cache ของ build/test",
+			InstallCmd:  "npm install",
+			StartCmd:    "npm run dev",
+		},
+		{
+			Name:        "nx",
+			DisplayName: "Nx",
+			Description: "nx.json พร้อม targetDefaults และ cache ของ build/test",
+			InstallCmd:  "npm install",
+			StartCmd:    "npm run dev",
+		},
+	}
+}
+
+// MonorepoFrontends frontend ที่ใช้ใน monorepo ได้ (ต้องเป็นแอป Node เพื่อเข้า workspace)
+func MonorepoFrontends() []FrameworkOption {
+	var out []FrameworkOption
+	for _, fw := range GetFrontendFrameworks() {
+		if fw.Runtime == "node" {
+			out = append(out, fw)
+		}
+	}
+	return out
+}
+
+// MonorepoBackends backend ที่ใช้ใน monorepo ได้ (ต้องเป็น server ที่ frontend proxy ไปหาได้)
+func MonorepoBackends() []FrameworkOption {
+	var out []FrameworkOption
+	for _, fw := range GetBackendFrameworks() {
+		if fw.Port >= 0 && fw.Name != "go-grpc" {
+			out = append(out, fw)
+		}
+	}
+	return out
 }
 
 // GetFrontendFrameworks คืนค่า frameworks สำหรับ Frontend
@@ -38,6 +237,8 @@ func GetFrontendFrameworks() []FrameworkOption {
 			InstallCmd:   "npm install",
 			StartCmd:     "npm run dev",
 			BuildCmd:     "npm run build",
+			ServeCmd:     "npm run preview -- --host 0.0.0.0 --port 5173",
+			Port:         5173,
 			Description:  "Vite with React and TypeScript - Fast, modern frontend tooling",
 			SupportedAddons: []string{"tailwindcss", "material-ui", "bootstrap", "eslint", "prettier"},
 		},
@@ -50,6 +251,8 @@ func GetFrontendFrameworks() []FrameworkOption {
 			InstallCmd:   "npm install",
 			StartCmd:     "npm run dev",
 			BuildCmd:     "npm run build",
+			ServeCmd:     "npm run preview -- --host 0.0.0.0 --port 5173",
+			Port:         5173,
 			Description:  "Vite with Vue 3 and TypeScript - Progressive JavaScript framework",
 			SupportedAddons: []string{"tailwindcss", "vuetify", "eslint", "prettier"},
 		},
@@ -62,6 +265,8 @@ func GetFrontendFrameworks() []FrameworkOption {
 			InstallCmd:   "npm install",
 			StartCmd:     "npm run dev",
 			BuildCmd:     "npm run build",
+			ServeCmd:     "npm run preview -- --host 0.0.0.0 --port 5173",
+			Port:         5173,
 			Description:  "Vite with Svelte and TypeScript - Cybernetically enhanced web apps",
 			SupportedAddons: []string{"tailwindcss", "eslint", "prettier"},
 		},
@@ -74,9 +279,21 @@ func GetFrontendFrameworks() []FrameworkOption {
 			InstallCmd:   "npm install",
 			StartCmd:     "npm run dev",
 			BuildCmd:     "npm run build",
+			ServeCmd:     "npm run start",
 			Description:  "Next.js with TypeScript and Tailwind CSS - React framework for production",
 			SupportedAddons: []string{"prisma", "auth", "eslint", "prettier"},
 		},
+		{
+			Name:         "fresh",
+			DisplayName:  "Fresh (Deno)",
+			Language:     "TypeScript",
+			TemplatePath: "templates/frontend/fresh",
+			Runtime:      "deno",
+			StartCmd:     "deno task dev",
+			BuildCmd:     "deno task build",
+			Port:         8000,
+			Description:  "Fresh - Deno web framework with server rendering and islands, no build step in development",
+		},
 	}
 }
 
@@ -107,6 +324,25 @@ func GetBackendFrameworks() []FrameworkOption {
 			Description:  "Express.js - Fast, unopinionated, minimalist web framework for Node.js",
 			SupportedAddons: []string{"mongodb", "postgresql", "mysql", "jwt", "cors"},
 		},
+		{
+			Name:         "hono-api",
+			DisplayName:  "Hono + TypeScript",
+			Language:     "TypeScript",
+			TemplatePath: "templates/backend/hono-api",
+			Runtime:      "bun",
+			Description:  "Hono - small, fast web framework on Web Standards that runs on Bun, Deno and Node.js",
+			Variants:     HonoRuntimes(),
+		},
+		{
+			Name:         "elysia-api",
+			DisplayName:  "Elysia + TypeScript (Bun)",
+			Language:     "TypeScript",
+			TemplatePath: "templates/backend/elysia-api",
+			Runtime:      "bun",
+			InstallCmd:   "bun install",
+			StartCmd:     "bun run dev",
+			Description:  "Elysia - ergonomic, end-to-end type safe web framework built for Bun",
+		},
 		{
 			Name:         "go-fiber",
 			DisplayName:  "Go + Fiber",
@@ -114,11 +350,121 @@ func GetBackendFrameworks() []FrameworkOption {
 			TemplatePath: "templates/backend/go-fiber",
 			Runtime:      "go",
 			InstallCmd:   "go mod tidy",
-			StartCmd:     "go run main.go",
-			BuildCmd:     "go build -o app",
+			StartCmd:     "go run .",
+			BuildCmd:     "go build -o app .",
 			Description:  "Fiber - Express-inspired web framework built on top of Fasthttp, the fastest HTTP engine for Go",
 			SupportedAddons: []string{"gorm", "postgresql", "mysql", "redis", "jwt"},
+			Variants:     GoLayouts(),
+		},
+		goHTTPFramework("go-nethttp", "Go + net/http", "templates/backend/go-nethttp",
+			"Standard library net/http with Go 1.22 routing patterns - no third-party router"),
+		goHTTPFramework("go-gin", "Go + Gin", "templates/backend/go-gin",
+			"Gin - fast HTTP web framework with a martini-like API"),
+		goHTTPFramework("go-echo", "Go + Echo", "templates/backend/go-echo",
+			"Echo - high performance, minimalist Go web framework"),
+		goHTTPFramework("go-chi", "Go + Chi", "templates/backend/go-chi",
+			"Chi - lightweight, idiomatic router built on net/http"),
+		{
+			Name:            "go-grpc",
+			DisplayName:     "Go + gRPC",
+			Language:        "Go",
+			TemplatePath:    "templates/backend/go-grpc",
+			Runtime:         "go",
+			InstallCmd:      "go mod tidy",
+			StartCmd:        "go run ./cmd/__name__",
+			BuildCmd:        "go build -o app ./cmd/__name__",
+			MainPackage:     "./cmd/__name__",
+			Port:            50051,
+			Description:     "gRPC service with protobuf, buf codegen config, health and reflection services",
+			SupportedAddons: []string{"postgresql", "mysql", "redis"},
 		},
+		{
+			Name:            "go-cli",
+			DisplayName:     "Go CLI (Cobra)",
+			Language:        "Go",
+			TemplatePath:    "templates/backend/go-cli",
+			Runtime:         "go",
+			InstallCmd:      "go mod tidy",
+			StartCmd:        "go run . --help",
+			BuildCmd:        "make build",
+			MainPackage:     ".",
+			Port:            -1,
+			Description:     "Command line application with Cobra subcommands, ldflags version injection, shell completion and goreleaser config",
+		},
+		{
+			Name:            "spring-boot",
+			DisplayName:     "Java + Spring Boot",
+			Language:        "Java",
+			TemplatePath:    "templates/backend/spring-boot",
+			Runtime:         "java",
+			Port:            8080,
+			MinVersion:      "21",
+			Description:     "Spring Boot - production-ready Java applications with Actuator health checks",
+			SupportedAddons: []string{"postgresql", "mysql", "redis"},
+			Variants:        JavaBuildTools(),
+		},
+		{
+			Name:            "axum-api",
+			DisplayName:     "Rust + Axum",
+			Language:        "Rust",
+			TemplatePath:    "templates/backend/axum-api",
+			Runtime:         "rust",
+			InstallCmd:      "cargo fetch",
+			StartCmd:        "cargo run",
+			BuildCmd:        "cargo build --release",
+			Port:            3000,
+			MinVersion:      "1.78",
+			Description:     "Axum - ergonomic and modular Rust web framework built on Tokio and Tower",
+			SupportedAddons: []string{"postgresql", "redis"},
+		},
+		pythonFramework("fastapi-api", "Python + FastAPI",
+			"uvicorn app.main:app --reload --port 8000",
+			"uvicorn app.main:app --host 0.0.0.0 --port 8000",
+			"FastAPI - modern, fast Python web framework for building APIs with type hints"),
+		pythonFramework("django-api", "Python + Django",
+			"python manage.py runserver 8000",
+			"gunicorn config.wsgi --bind 0.0.0.0:8000",
+			"Django - batteries-included Python web framework"),
+		pythonFramework("flask-api", "Python + Flask",
+			"flask --app app run --debug --port 8000",
+			"gunicorn app:create_app() --bind 0.0.0.0:8000",
+			"Flask - lightweight WSGI micro framework for Python"),
+	}
+}
+
+// pythonFramework เทมเพลต Python ที่ใช้ pyproject.toml และติดตั้งลง virtualenv (.venv)
+// InstallCmd/StartCmd เป็นรูปแบบ venv + pip และถูกปรับเป็น uv ตอนสร้างโปรเจ็กต์ถ้าเครื่องมี uv
+func pythonFramework(name, displayName, startCmd, serveCmd, description string) FrameworkOption {
+	return FrameworkOption{
+		Name:         name,
+		DisplayName:  displayName,
+		Language:     "Python",
+		TemplatePath: "templates/backend/" + name,
+		Runtime:      "python",
+		InstallCmd:   `python -m venv .venv && .venv/bin/python -m pip install -e ".[dev]"`,
+		StartCmd:     startCmd,
+		Port:         8000,
+		MinVersion:   "3.10",
+		ServeCmd:     serveCmd,
+		Description:  description,
+	}
+}
+
+// goHTTPFramework เทมเพลต Go HTTP service ที่ใช้โครงสร้าง cmd/<name> + internal/ ร่วมกัน
+// (health endpoint, graceful shutdown, log/slog, config จาก env และ tests)
+func goHTTPFramework(name, displayName, templatePath, description string) FrameworkOption {
+	return FrameworkOption{
+		Name:            name,
+		DisplayName:     displayName,
+		Language:        "Go",
+		TemplatePath:    templatePath,
+		Runtime:         "go",
+		InstallCmd:      "go mod tidy",
+		StartCmd:        "go run ./cmd/__name__",
+		BuildCmd:        "go build -o app ./cmd/__name__",
+		MainPackage:     "./cmd/__name__",
+		Description:     description,
+		SupportedAddons: []string{"postgresql", "mysql", "redis", "jwt"},
 	}
 }
 
@@ -146,10 +492,118 @@ func GetFullstackFrameworks() []FrameworkOption {
 			InstallCmd:   "npm run install-all",
 			StartCmd:     "npm run dev",
 			BuildCmd:     "npm run build",
+			Port:         5000,
 			Description:  "MERN Stack - Full-stack JavaScript solution",
 			SupportedAddons: []string{"redux", "tailwindcss", "jwt", "mongoose"},
 		},
+		{
+			Name:         MonorepoName,
+			DisplayName:  "Monorepo (Frontend + Backend)",
+			Language:     "TypeScript",
+			TemplatePath: "templates/fullstack/monorepo",
+			Runtime:      "node",
+			InstallCmd:   "npm install",
+			StartCmd:     "npm run dev",
+			Description:  "Monorepo - compose a frontend and a backend template into apps/web and apps/api with workspaces, dev proxy and docker-compose",
+			Variants:     WorkspaceTools(),
+		},
+	}
+}
+
+// GetLibraryFrameworks คืนค่า frameworks สำหรับ library ที่ publish ให้โปรเจ็กต์อื่นใช้
+func GetLibraryFrameworks() []FrameworkOption {
+	return []FrameworkOption{
+		{
+			Name:         "npm-lib",
+			DisplayName:  "npm package (TypeScript)",
+			Language:     "TypeScript",
+			TemplatePath: "templates/library/npm-lib",
+			Runtime:      "node",
+			InstallCmd:   "npm install",
+			StartCmd:     "npm run dev",
+			BuildCmd:     "npm run build",
+			Port:         -1,
+			MinVersion:   "20",
+			Variants:     LibraryBundlers(),
+			Description:  "Publishable npm package - ESM + CJS builds, exports map, type declarations, Vitest and Changesets",
+		},
+		{
+			Name:         "go-lib",
+			DisplayName:  "Go module",
+			Language:     "Go",
+			TemplatePath: "templates/library/go-lib",
+			Runtime:      "go",
+			InstallCmd:   "go mod tidy",
+			StartCmd:     "go test ./...",
+			BuildCmd:     "go build ./...",
+			Port:         -1,
+			Description:  "Importable Go module - package doc, example tests and a version-matrix CI workflow",
+		},
+	}
+}
+
+// GetMobileFrameworks คืนค่า frameworks สำหรับแอปมือถือ
+func GetMobileFrameworks() []FrameworkOption {
+	return []FrameworkOption{
+		{
+			Name:         "expo",
+			DisplayName:  "Expo (React Native)",
+			Language:     "TypeScript",
+			TemplatePath: "templates/mobile/expo",
+			Runtime:      "node",
+			InstallCmd:   "npm install",
+			StartCmd:     "npm start",
+			BuildCmd:     "npm run build",
+			Port:         -1,
+			MinVersion:   "20.19",
+			Description:  "Expo - React Native apps for iOS, Android and web with Expo Go, EAS Build and jest-expo tests",
+		},
+	}
+}
+
+// GetDesktopFrameworks คืนค่า frameworks สำหรับแอปเดสก์ท็อป
+func GetDesktopFrameworks() []FrameworkOption {
+	return []FrameworkOption{
+		{
+			Name:         "tauri",
+			DisplayName:  "Tauri + Vite",
+			Language:     "TypeScript",
+			TemplatePath: "templates/desktop/tauri",
+			Runtime:      "node",
+			InstallCmd:   "npm install",
+			StartCmd:     "npm run tauri dev",
+			BuildCmd:     "npm run tauri build",
+			Port:         -1,
+			MinVersion:   "18",
+			Toolchains:   []string{"cargo"},
+			FrontendBase: true,
+			Description:  "Tauri 2 - small, secure desktop apps with a Rust core and a Vite (React, Vue or Svelte) frontend",
+		},
+		{
+			Name:         "electron",
+			DisplayName:  "Electron",
+			Language:     "JavaScript",
+			TemplatePath: "templates/desktop/electron",
+			Runtime:      "node",
+			InstallCmd:   "npm install",
+			StartCmd:     "npm start",
+			BuildCmd:     "npm run dist",
+			Port:         -1,
+			MinVersion:   "20",
+			Description:  "Electron - cross-platform desktop apps with Chromium and Node.js, context-isolated preload and electron-builder packaging",
+		},
+	}
+}
+
+// ViteFrontends คืนเทมเพลต Vite ที่ใช้เป็น frontend ของแอปเดสก์ท็อป (ตัวแรกเป็นค่าเริ่มต้น)
+func ViteFrontends() []FrameworkOption {
+	var out []FrameworkOption
+	for _, fw := range GetFrontendFrameworks() {
+		if strings.HasPrefix(fw.Name, "vite-") {
+			out = append(out, fw)
+		}
 	}
+	return out
 }
 
 // CSSFrameworkOption ตัวเลือก CSS frameworks
@@ -245,13 +699,13 @@ func GetExtras() []ExtraOption {
 			Name:        "eslint",
 			DisplayName: "ESLint",
 			Action:      "run-command",
-			Value:       "npm install -D eslint && npx eslint --init",
+			Value:       "npm install -D eslint",
 		},
 		{
 			Name:        "prettier",
 			DisplayName: "Prettier",
 			Action:      "run-command",
-			Value:       "npm install -D prettier && echo {} > .prettierrc",
+			Value:       "npm install -D prettier",
 		},
 		{
 			Name:        "github-actions",
@@ -259,6 +713,12 @@ func GetExtras() []ExtraOption {
 			Action:      "create-file",
 			Value:       ".github/workflows/ci.yml",
 		},
+		{
+			Name:        "gitlab-ci",
+			DisplayName: "GitLab CI/CD",
+			Action:      "create-file",
+			Value:       ".gitlab-ci.yml",
+		},
 		{
 			Name:        "env",
 			DisplayName: ".env file",
@@ -273,3 +733,73 @@ func GetExtras() []ExtraOption {
 		},
 	}
 }
+
+// CompatibleRuntimes รันไทม์ที่ framework ใช้ได้ ตามลำดับใน Variants หรือ Runtime ของ framework เอง
+func CompatibleRuntimes(fw FrameworkOption) []string {
+	var runtimes []string
+	for _, v := range fw.Variants {
+		if v.Runtime != "" {
+			runtimes = append(runtimes, v.Runtime)
+		}
+	}
+	if len(runtimes) == 0 && fw.Runtime != "" {
+		runtimes = append(runtimes, fw.Runtime)
+	}
+	return runtimes
+}
+
+// RuntimeVariants ตรวจว่า variants ของ framework เป็นตัวเลือกของรันไทม์หรือไม่
+func RuntimeVariants(fw FrameworkOption) bool {
+	return len(fw.Variants) > 0 && fw.Variants[0].Runtime != ""
+}
+
+// AllFrameworks คืน framework ทุกตัวในแค็ตตาล็อก เรียงตามประเภทโปรเจค
+func AllFrameworks() []FrameworkOption {
+	var all []FrameworkOption
+	for _, pt := range ProjectTypes() {
+		all = append(all, FrameworksFor(pt)...)
+	}
+	return all
+}
+
+// FindFramework ค้นหา framework จากชื่อในทุกประเภทโปรเจค
+func FindFramework(name string) (FrameworkOption, ProjectType, bool) {
+	for _, pt := range ProjectTypes() {
+		for _, fw := range FrameworksFor(pt) {
+			if fw.Name == name {
+				return fw, pt, true
+			}
+		}
+	}
+	return FrameworkOption{}, "", false
+}
+
+// FindExtra ค้นหาตัวเลือกเสริมจาก Name หรือ DisplayName
+func FindExtra(name string) (ExtraOption, bool) {
+	for _, ex := range GetExtras() {
+		if ex.Name == name || ex.DisplayName == name {
+			return ex, true
+		}
+	}
+	return ExtraOption{}, false
+}
+
+// FindCSSFramework ค้นหา CSS framework จาก Name
+func FindCSSFramework(name string) (CSSFrameworkOption, bool) {
+	for _, css := range GetCSSFrameworks() {
+		if css.Name == name && css.Name != "none" {
+			return css, true
+		}
+	}
+	return CSSFrameworkOption{}, false
+}
+
+// FindUILibrary ค้นหา UI library จาก Name
+func FindUILibrary(name string) (UILibraryOption, bool) {
+	for _, lib := range GetUILibraries() {
+		if lib.Name == name && lib.Name != "none" {
+			return lib, true
+		}
+	}
+	return UILibraryOption{}, false
+}
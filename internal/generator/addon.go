@@ -0,0 +1,219 @@
+package generator
+
+// เพิ่ม addon/extra ให้โปรเจ็กต์ที่มีอยู่แล้ว (projgen add) โดยใช้เส้นทางโค้ดเดียวกับตอนสร้างโปรเจ็กต์
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/manifest"
+	"projgen/internal/ui"
+)
+
+// AddOptions ตัวเลือกของ projgen add
+type AddOptions struct {
+	Force   bool                           // เขียนทับไฟล์ที่มีอยู่แล้วโดยไม่ถาม
+	Install bool                           // รันคำสั่งติดตั้งของ addon
+	Confirm func(rel string) (bool, error) // ถามผู้ใช้เมื่อไฟล์ชนกัน (nil = ปฏิเสธ)
+}
+
+// AddonNames คืนชื่อ addon/extra ทั้งหมดที่ projgen add รองรับ
+func AddonNames() []string {
+	var names []string
+	for _, ex := range config.GetExtras() {
+		names = append(names, ex.Name)
+	}
+	for _, css := range config.GetCSSFrameworks() {
+		if css.Name != "none" {
+			names = append(names, css.Name)
+		}
+	}
+	for _, lib := range config.GetUILibraries() {
+		if lib.Name != "none" {
+			names = append(names, lib.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Add เพิ่ม addon หรือ extra ชื่อ name ให้โปรเจ็กต์ใน dir
+func Add(ctx context.Context, dir, name string, cfg *config.Config, aopts AddOptions) error {
+	opts, m, err := loadProject(dir, cfg)
+	if err != nil {
+		return err
+	}
+	w := newWriter(dir)
+
+	// ไฟล์ที่ชนกัน: --force เขียนทับ, มี Confirm ให้ถาม, ไม่งั้นปฏิเสธ
+	var refused []string
+	overwrite := func(rel string) (bool, error) {
+		if aopts.Force {
+			return true, nil
+		}
+		if aopts.Confirm != nil {
+			ok, err := aopts.Confirm(rel)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		refused = append(refused, rel)
+		return false, nil
+	}
+
+	var cmdStr, display string
+	var configFiles, userOwned []string
+	if ex, ok := config.FindExtra(name); ok {
+		display = ex.DisplayName
+		if contains(opts.Extras, ex.Name) && !aopts.Force {
+			pterm.Info.Printfln("%s ถูกเพิ่มไว้แล้วในโปรเจ็กต์นี้", ex.DisplayName)
+			return nil
+		}
+		files := extraFiles(ex.Name, opts)
+		if len(files) == 0 && extraCommand(ex, opts) == "" {
+			return fmt.Errorf("%s ยังไม่รองรับสำหรับโปรเจ็กต์ %s", ex.DisplayName, opts.Framework.DisplayName)
+		}
+		// .gitignore เดิมถูกรวมเข้าไป (ไม่ลบ pattern ของผู้ใช้) จึงไม่ต้องถามก่อนเขียนทับ
+		// ไฟล์ที่ผู้ใช้สร้างเองยังเป็นของผู้ใช้ ไม่บันทึก hash ลง manifest เพื่อไม่ให้ update เขียนทับ pattern ที่เพิ่มไว้
+		if ex.Name == "gitignore" {
+			if w.exists(".gitignore") && (m == nil || m.Files[".gitignore"] == "") {
+				userOwned = append(userOwned, ".gitignore")
+			}
+			err = applyGitignore(w, opts)
+		} else {
+			err = applyExtra(w, ex.Name, opts, overwrite)
+		}
+		if err != nil {
+			return err
+		}
+		if len(refused) > 0 {
+			return conflictError(refused)
+		}
+		cmdStr = extraCommand(ex, opts)
+		if !contains(opts.Extras, ex.Name) {
+			opts.Extras = append(opts.Extras, ex.Name)
+		}
+	} else if css, ok := config.FindCSSFramework(name); ok {
+		display = css.DisplayName
+		if opts.CSSFramework != nil && opts.CSSFramework.Name == css.Name && !aopts.Force {
+			pterm.Info.Printfln("%s ถูกเพิ่มไว้แล้วในโปรเจ็กต์นี้", css.DisplayName)
+			return nil
+		}
+		if err := checkAddonSupport(opts, css.Name); err != nil {
+			return err
+		}
+		// ไฟล์ config ถูกสร้างโดยคำสั่งติดตั้ง จึงต้องลบไฟล์เดิมออกก่อนเมื่อยืนยันให้เขียนทับ
+		for _, rel := range css.ConfigFiles {
+			if !w.exists(rel) {
+				continue
+			}
+			ok, err := overwrite(rel)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := os.Remove(filepath.Join(dir, filepath.FromSlash(rel))); err != nil {
+					return err
+				}
+			}
+		}
+		if len(refused) > 0 {
+			return conflictError(refused)
+		}
+		opts.CSSFramework = &css
+		opts = applyPackageManager(opts)
+		cmdStr = opts.CSSFramework.InstallCmd
+		configFiles = css.ConfigFiles
+	} else if lib, ok := config.FindUILibrary(name); ok {
+		display = lib.DisplayName
+		if opts.UILibrary != nil && opts.UILibrary.Name == lib.Name && !aopts.Force {
+			pterm.Info.Printfln("%s ถูกเพิ่มไว้แล้วในโปรเจ็กต์นี้", lib.DisplayName)
+			return nil
+		}
+		if err := checkAddonSupport(opts, lib.Name); err != nil {
+			return err
+		}
+		opts.UILibrary = &lib
+		opts = applyPackageManager(opts)
+		cmdStr = opts.UILibrary.InstallCmd
+	} else {
+		return fmt.Errorf("ไม่รู้จัก addon %q (รองรับ: %s)", name, strings.Join(AddonNames(), ", "))
+	}
+
+	for _, rel := range w.files {
+		pterm.Success.Printfln("สร้าง %s", rel)
+	}
+
+	if cmdStr != "" {
+		if !aopts.Install {
+			pterm.Info.Printfln("   💡 ติดตั้ง %s ด้วยคำสั่ง: %s", display, pterm.Cyan(cmdStr))
+		} else {
+			// คำสั่งติดตั้งแก้ package.json/lockfile ที่ manifest บันทึกไว้ ต้องบันทึก hash ใหม่ไม่ให้ status นับเป็นการแก้ไขของผู้ใช้
+			var before map[string]string
+			if m != nil {
+				if before, err = trackedHashes(m, dir); err != nil {
+					return err
+				}
+			}
+			spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("🔧 กำลังติดตั้ง %s...", display))
+			if err := runCommandInDir(ctx, dir, cmdStr); err != nil {
+				spinner.Fail(fmt.Sprintf("ติดตั้ง %s ไม่สำเร็จ", display))
+				return err
+			}
+			spinner.Success(fmt.Sprintf("ติดตั้ง %s สำเร็จ", display))
+			if m != nil {
+				if err := recordInstalled(m, dir, before); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if m == nil {
+		return nil
+	}
+	// บันทึกไฟล์ที่ addon สร้างลง manifest เพื่อให้ status/remove ตรวจสอบได้
+	hashes, err := manifest.HashFiles(dir, append(w.files, configFiles...))
+	if err != nil {
+		return err
+	}
+	for _, rel := range userOwned {
+		delete(hashes, rel)
+	}
+	for rel, h := range hashes {
+		m.Files[rel] = h
+		delete(m.Installed, rel)
+	}
+	for extra, owned := range w.extras {
+		if m.Extras == nil {
+			m.Extras = map[string][]string{}
+		}
+		m.Extras[extra] = owned
+	}
+	m.Options = opts
+	m.UpdatedAt = time.Now().UTC()
+	return manifest.Write(dir, m)
+}
+
+// checkAddonSupport ตรวจว่า framework ของโปรเจ็กต์รองรับ addon หรือไม่
+func checkAddonSupport(opts ui.ProjectOptions, name string) error {
+	if opts.Framework.Runtime != "" && opts.Framework.Runtime != "node" {
+		return fmt.Errorf("%s ใช้ได้กับโปรเจ็กต์ Node เท่านั้น", name)
+	}
+	if len(opts.Framework.SupportedAddons) > 0 && !contains(opts.Framework.SupportedAddons, name) {
+		pterm.Warning.Printfln("%s ไม่อยู่ในรายการ addon ที่ %s รองรับอย่างเป็นทางการ", name, opts.Framework.DisplayName)
+	}
+	return nil
+}
+
+func conflictError(files []string) error {
+	return fmt.Errorf("มีไฟล์อยู่แล้ว: %s (ใช้ --force เพื่อเขียนทับ)", strings.Join(files, ", "))
+}
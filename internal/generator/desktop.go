@@ -0,0 +1,78 @@
+package generator
+
+// แอปเดสก์ท็อปที่ต่อยอดจากเทมเพลต frontend (Tauri): เรนเดอร์เทมเพลต Vite ที่เลือกเป็นฐานที่ root
+// แล้ววางไฟล์ของเทมเพลตเดสก์ท็อป (src-tauri/) ทับ และเติม CLI/สคริปต์ของ Tauri ลงใน package.json และ vite.config.ts
+
+import (
+	"projgen/internal/config"
+	"projgen/internal/ui"
+)
+
+// tauriPackages เวอร์ชันของแพ็กเกจ Tauri ที่เติมลง package.json ของ frontend
+var tauriPackages = struct {
+	API, Opener, CLI string
+}{
+	API:    "^2.8.0",
+	Opener: "^2.5.0",
+	CLI:    "^2.8.4",
+}
+
+// withFrontendBase ใส่เทมเพลต Vite ค่าเริ่มต้นเมื่อยังไม่ได้เลือก (เช่น สร้างจาก spec หรือ manifest รุ่นเก่า)
+func withFrontendBase(opts ui.ProjectOptions) ui.ProjectOptions {
+	if !opts.Framework.FrontendBase || opts.Frontend != nil {
+		return opts
+	}
+	if vite := config.ViteFrontends(); len(vite) > 0 {
+		opts.Frontend = &vite[0]
+	}
+	return opts
+}
+
+// frontendBaseOptions options ของเทมเพลต frontend ที่ใช้เป็นฐาน (ชื่อโปรเจ็กต์และผู้เขียนเดียวกัน)
+func frontendBaseOptions(opts ui.ProjectOptions) ui.ProjectOptions {
+	base := opts
+	base.ProjectType = config.Frontend
+	base.Framework = *opts.Frontend
+	base.Runtime = opts.Frontend.Runtime
+	base.Variant = ""
+	base.Frontend = nil
+	return base
+}
+
+// renderFrontendBase เรนเดอร์เทมเพลต frontend ที่เลือกลง root ก่อนเทมเพลตหลัก
+func renderFrontendBase(w *writer, opts ui.ProjectOptions, sources []string) error {
+	base := frontendBaseOptions(opts)
+	tmplDir := resolveTemplateDir(base, sources)
+	if tmplDir == "" {
+		return missingTemplateError(base.Framework)
+	}
+	return copyRenderTemplateDir(w, tmplDir, base)
+}
+
+// addTauriToFrontend เติม @tauri-apps/* และสคริปต์ tauri ลง package.json และตั้งค่า dev server ของ Vite ให้ Tauri ใช้ได้
+// (พอร์ตคงที่ตาม devUrl ใน tauri.conf.json และไม่ watch โฟลเดอร์ src-tauri)
+func addTauriToFrontend(w *writer, opts ui.ProjectOptions) error {
+	base := frontendBaseOptions(opts)
+	edits := []struct{ rel, old, new string }{
+		{"package.json", `"name": "` + base.Framework.Name + `"`, `"name": "` + toKebab(opts.Name) + `"`},
+		{"package.json", "\"scripts\": {\n", "\"scripts\": {\n    \"tauri\": \"tauri\",\n"},
+		{"package.json", "\"dependencies\": {\n", "\"dependencies\": {\n" +
+			"    \"@tauri-apps/api\": \"" + tauriPackages.API + "\",\n" +
+			"    \"@tauri-apps/plugin-opener\": \"" + tauriPackages.Opener + "\",\n"},
+		{"package.json", "\"devDependencies\": {\n", "\"devDependencies\": {\n    \"@tauri-apps/cli\": \"" + tauriPackages.CLI + "\",\n"},
+		{"vite.config.ts", "export default defineConfig({\n", "export default defineConfig({\n" +
+			"  // Tauri expects a fixed port (devUrl in src-tauri/tauri.conf.json) and rebuilds the Rust side itself.\n" +
+			"  clearScreen: false,\n" +
+			"  server: {\n" +
+			"    port: 5173,\n" +
+			"    strictPort: true,\n" +
+			"    watch: { ignored: ['**/src-tauri/**'] },\n" +
+			"  },\n"},
+	}
+	for _, e := range edits {
+		if err := patchFile(w, e.rel, e.old, e.new); err != nil {
+			return err
+		}
+	}
+	return nil
+}
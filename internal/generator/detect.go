@@ -0,0 +1,186 @@
+package generator
+
+// ตรวจจับ framework ของโปรเจ็กต์ที่ไม่มี manifest จาก package.json หรือ go.mod
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"projgen/internal/config"
+	"projgen/internal/manifest"
+	"projgen/internal/ui"
+)
+
+// loadProject อ่านตัวเลือกของโปรเจ็กต์จาก manifest หากมี ไม่งั้นตรวจจับจากไฟล์ในโปรเจ็กต์
+// คืน manifest เป็น nil เมื่อไม่มีไฟล์ .projgen.json
+func loadProject(dir string, cfg *config.Config) (ui.ProjectOptions, *manifest.Manifest, error) {
+	m, err := manifest.Read(dir)
+	if err == nil {
+		return m.Options, m, nil
+	}
+	if !errors.Is(err, manifest.ErrNotFound) {
+		return ui.ProjectOptions{}, nil, err
+	}
+	opts, err := detectProject(dir)
+	if err != nil {
+		return ui.ProjectOptions{}, nil, err
+	}
+	opts.PackageManager = detectPackageManager(dir, cfg.PackageManager)
+	opts.AuthorName = cfg.AuthorName
+	opts.AuthorEmail = cfg.AuthorEmail
+	opts.License = cfg.License
+	return applyVariant(opts), nil, nil
+}
+
+// detectProject ตรวจจับ framework จาก package.json หรือ go.mod
+func detectProject(dir string) (ui.ProjectOptions, error) {
+	opts := ui.ProjectOptions{Name: filepath.Base(dir)}
+	if abs, err := filepath.Abs(dir); err == nil {
+		opts.Name = filepath.Base(abs)
+	}
+
+	if b, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		var pkg struct {
+			Name            string            `json:"name"`
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+		if err := json.Unmarshal(b, &pkg); err != nil {
+			return opts, err
+		}
+		if pkg.Name != "" {
+			opts.Name = pkg.Name
+		}
+		deps := map[string]bool{}
+		for name := range pkg.Dependencies {
+			deps[name] = true
+		}
+		for name := range pkg.DevDependencies {
+			deps[name] = true
+		}
+
+		opts.Framework = config.FrameworkOption{Name: "node", DisplayName: "Node.js", Language: "JavaScript", Runtime: "node", InstallCmd: "npm install"}
+		if deps["typescript"] {
+			opts.Framework.Language = "TypeScript"
+		}
+		// เรียงจาก framework ที่เฉพาะเจาะจงที่สุดก่อน
+		rules := []struct {
+			framework string
+			match     func() bool
+		}{
+			{"t3-stack", func() bool { return deps["next"] && deps["@trpc/server"] }},
+			{"nextjs-ts", func() bool { return deps["next"] }},
+			{"nestjs-api", func() bool { return deps["@nestjs/core"] }},
+			{"vite-vue-ts", func() bool { return deps["vue"] }},
+			{"vite-svelte-ts", func() bool { return deps["svelte"] }},
+			{"vite-react-ts", func() bool { return deps["react"] && deps["vite"] }},
+			{"express-api", func() bool { return deps["express"] }},
+		}
+		for _, r := range rules {
+			if !r.match() {
+				continue
+			}
+			if fw, pt, ok := config.FindFramework(r.framework); ok {
+				opts.Framework, opts.ProjectType = fw, pt
+			}
+			break
+		}
+		for _, css := range config.GetCSSFrameworks() {
+			if deps[css.Name] || (css.Name == "material-ui" && deps["@mui/material"]) {
+				c := css
+				opts.CSSFramework = &c
+				break
+			}
+		}
+		opts.Runtime = opts.Framework.Runtime
+		return opts, nil
+	}
+
+	if module, requires, err := readGoMod(filepath.Join(dir, "go.mod")); err == nil {
+		opts.Name = filepath.Base(module)
+		opts.GoModule = module
+		opts.Framework = config.FrameworkOption{Name: "go", DisplayName: "Go", Language: "Go", Runtime: "go", InstallCmd: "go mod tidy"}
+		opts.ProjectType = config.Backend
+		goRules := []struct{ prefix, framework string }{
+			{"github.com/gofiber/fiber", "go-fiber"},
+			{"github.com/gin-gonic/gin", "go-gin"},
+			{"github.com/labstack/echo", "go-echo"},
+			{"github.com/go-chi/chi", "go-chi"},
+			{"google.golang.org/grpc", "go-grpc"},
+			{"github.com/spf13/cobra", "go-cli"},
+		}
+	detectGo:
+		for _, req := range requires {
+			for _, r := range goRules {
+				if strings.HasPrefix(req, r.prefix) {
+					opts.Framework, _, _ = config.FindFramework(r.framework)
+					break detectGo
+				}
+			}
+		}
+		// โครงสร้างแบบ cmd/<name> ตรวจจากโฟลเดอร์ cmd
+		if fi, err := os.Stat(filepath.Join(dir, "cmd")); err == nil && fi.IsDir() && len(opts.Framework.Variants) > 0 {
+			opts.Variant = "standard"
+		}
+		opts.Runtime = "go"
+		return opts, nil
+	}
+
+	return opts, errors.New("ไม่พบ " + manifest.FileName + ", package.json หรือ go.mod — ไม่สามารถระบุชนิดของโปรเจ็กต์ได้")
+}
+
+// detectPackageManager ตรวจจับ package manager จาก lock file (ใช้ค่าจาก config ถ้าไม่พบ)
+func detectPackageManager(dir, fallback string) string {
+	locks := []struct{ file, pm string }{
+		{"pnpm-lock.yaml", "pnpm"},
+		{"yarn.lock", "yarn"},
+		{"bun.lockb", "bun"},
+		{"bun.lock", "bun"},
+		{"package-lock.json", "npm"},
+	}
+	for _, l := range locks {
+		if _, err := os.Stat(filepath.Join(dir, l.file)); err == nil {
+			return l.pm
+		}
+	}
+	return fallback
+}
+
+// readGoMod อ่าน module path และรายการ require จาก go.mod แบบง่าย
+func readGoMod(path string) (string, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	var module string
+	var requires []string
+	inBlock := false
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case strings.HasPrefix(line, "module "):
+			module = strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "module ")), `"`)
+		case line == "require (":
+			inBlock = true
+		case inBlock && line == ")":
+			inBlock = false
+		case inBlock && line != "" && !strings.HasPrefix(line, "//"):
+			requires = append(requires, strings.Fields(line)[0])
+		case strings.HasPrefix(line, "require "):
+			if fields := strings.Fields(line); len(fields) > 1 {
+				requires = append(requires, fields[1])
+			}
+		}
+	}
+	if module == "" {
+		return "", nil, errors.New("go.mod ไม่มี module directive")
+	}
+	return module, requires, sc.Err()
+}
@@ -0,0 +1,158 @@
+package generator
+
+// สร้าง unified diff แบบบรรทัดต่อบรรทัดสำหรับแสดงผลในเทอร์มินัล (ใช้กับ --dry-run และการถามเมื่อไฟล์ชนกัน)
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+const diffContext = 3
+
+// diffOp หนึ่งบรรทัดของผลต่าง: ' ' เท่าเดิม, '-' ถูกลบ, '+' ถูกเพิ่ม
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// unifiedDiff คืน diff ระหว่าง a (เดิม) และ b (ใหม่) ในรูปแบบ unified (สตริงว่างหากเหมือนกัน)
+func unifiedDiff(name string, a, b []byte) string {
+	if string(a) == string(b) {
+		return ""
+	}
+	ops := diffLines(splitLines(string(a)), splitLines(string(b)))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", name, name)
+
+	// รวม ops เป็น hunks โดยมีบรรทัดบริบทรอบการเปลี่ยนแปลง
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			// หยุด hunk เมื่อบรรทัดเท่าเดิมต่อเนื่องเกินสองเท่าของบริบท
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*diffContext {
+				end += min(diffContext, run-end)
+				break
+			}
+			end = run
+		}
+
+		aStart, bStart := lineNumbers(ops, start)
+		aLen, bLen := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				aLen++
+			}
+			if op.kind != '-' {
+				bLen++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+		for _, op := range ops[start:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			sb.WriteByte('\n')
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// printDiff แสดง diff พร้อมสี
+func printDiff(diff string) {
+	for _, line := range strings.Split(strings.TrimRight(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			pterm.Println(pterm.Bold.Sprint(line))
+		case strings.HasPrefix(line, "@@"):
+			pterm.Println(pterm.Cyan(line))
+		case strings.HasPrefix(line, "+"):
+			pterm.Println(pterm.Green(line))
+		case strings.HasPrefix(line, "-"):
+			pterm.Println(pterm.Red(line))
+		default:
+			pterm.Println(line)
+		}
+	}
+}
+
+// diffLines หา longest common subsequence ของบรรทัดแล้วแปลงเป็นลำดับการเปลี่ยนแปลง
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+// lineNumbers คืนเลขบรรทัด (เริ่มที่ 1) ของไฟล์ a และ b ณ ตำแหน่ง op ที่ idx
+func lineNumbers(ops []diffOp, idx int) (int, int) {
+	a, b := 1, 1
+	for _, op := range ops[:idx] {
+		if op.kind != '+' {
+			a++
+		}
+		if op.kind != '-' {
+			b++
+		}
+	}
+	return a, b
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
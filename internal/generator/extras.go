@@ -0,0 +1,329 @@
+package generator
+
+// ไฟล์และคำสั่งของตัวเลือกเสริม (extras) ใช้ร่วมกันระหว่าง projgen create และ projgen add
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"projgen/internal/config"
+	"projgen/internal/gitignore"
+	"projgen/internal/ui"
+)
+
+// extraFiles คืนไฟล์ที่ extra สร้าง (relative path -> เนื้อหา)
+// extra ที่เป็นคำสั่งอย่างเดียว (เช่น eslint) จะคืน map ว่าง
+func extraFiles(name string, opts ui.ProjectOptions) map[string]string {
+	switch name {
+	case "dockerfile":
+		// monorepo มี Dockerfile ในโฟลเดอร์ของแต่ละแอปอยู่แล้ว ส่วนแอปมือถือ/เดสก์ท็อปและ library ไม่ได้รันใน container
+		if isMonorepo(opts) || isContainerless(opts) {
+			return map[string]string{}
+		}
+		return map[string]string{"Dockerfile": dockerfileFor(opts)}
+	case "docker-compose":
+		if isContainerless(opts) {
+			return map[string]string{}
+		}
+		if isMonorepo(opts) {
+			web, api := monorepoApps(opts)
+			return map[string]string{"docker-compose.yml": monorepoCompose(web, api)}
+		}
+		return map[string]string{"docker-compose.yml": composeFor(opts)}
+	case "github-actions":
+		return map[string]string{".github/workflows/ci.yml": ciWorkflowFor(opts)}
+	case "gitlab-ci":
+		return map[string]string{".gitlab-ci.yml": gitlabCIFor(opts)}
+	case "env":
+		env := fmt.Sprintf("APP_NAME=%s\n", toKebab(opts.Name))
+		if port := defaultPort(opts); port != 0 {
+			env = fmt.Sprintf("PORT=%d\n", port) + env
+		}
+		return map[string]string{".env": env}
+	case "prettier":
+		return map[string]string{".prettierrc": "{}\n"}
+	case "gitignore":
+		return map[string]string{".gitignore": gitignore.Compose("", gitignoreFragments(opts, hasDocker(opts))...)}
+	default:
+		return map[string]string{}
+	}
+}
+
+// isContainerless ตรวจว่าเป็นแอปมือถือ/เดสก์ท็อปหรือ library ซึ่งไม่มี Dockerfile
+func isContainerless(opts ui.ProjectOptions) bool {
+	return opts.ProjectType == config.Mobile || opts.ProjectType == config.Desktop || opts.ProjectType == config.Library
+}
+
+// extraCommand คืนคำสั่งที่ extra ต้องรัน (ปรับตาม package manager แล้ว) หรือสตริงว่าง
+func extraCommand(ex config.ExtraOption, opts ui.ProjectOptions) string {
+	if ex.Action != "run-command" || ex.Value == "" {
+		return ""
+	}
+	// คำสั่งของ extras ในแค็ตตาล็อกเป็นคำสั่งฝั่ง Node
+	if opts.Framework.Runtime != "" && opts.Framework.Runtime != "node" {
+		return ""
+	}
+	pm := strings.ToLower(opts.PackageManager)
+	if pm == "" || pm == "npm" {
+		return ex.Value
+	}
+	return adaptCommand(pm, ex.Value)
+}
+
+// applyExtra เขียนไฟล์ของ extra ผ่าน writer
+// overwrite ตัดสินว่าไฟล์ที่มีอยู่แล้วจะถูกเขียนทับหรือไม่
+// nil = ข้ามไฟล์ที่เทมเพลตเขียนไปแล้ว ส่วนไฟล์เดิมในโฟลเดอร์ให้ writer จัดการตาม conflict policy
+// ไฟล์ที่เขียนจริงถูกบันทึกไว้ใน w.extras เพื่อให้ projgen remove ไม่ลบไฟล์ของเทมเพลตที่ชื่อตรงกัน
+func applyExtra(w *writer, name string, opts ui.ProjectOptions, overwrite func(rel string) (bool, error)) error {
+	if w.extras == nil {
+		w.extras = map[string][]string{}
+	}
+	owned := []string{}
+	defer func() { w.extras[name] = owned }()
+
+	files := extraFiles(name, opts)
+	rels := make([]string, 0, len(files))
+	for rel := range files {
+		rels = append(rels, rel)
+	}
+	sort.Strings(rels)
+
+	for _, rel := range rels {
+		if overwrite == nil && w.wrote(rel) {
+			continue
+		}
+		if overwrite != nil && w.exists(rel) {
+			ok, err := overwrite(rel)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		if err := w.writeFile(rel, []byte(files[rel])); err != nil {
+			return err
+		}
+		// ไฟล์ที่ชนกับไฟล์เดิมในโหมด merge อาจถูกข้ามหรือรอถาม ไม่นับว่า extra เป็นเจ้าของ
+		if full := w.full(rel); contains(w.files, full) {
+			owned = append(owned, full)
+		}
+	}
+	return nil
+}
+
+// composeFor สร้าง docker-compose.yml สำหรับรันแอปจาก Dockerfile ในโปรเจ็กต์
+func composeFor(opts ui.ProjectOptions) string {
+	if isMERN(opts) {
+		return mernCompose(opts)
+	}
+	port := defaultPort(opts)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "services:\n  %s:\n    build: .\n", toKebab(opts.Name))
+	if port != 0 {
+		fmt.Fprintf(&sb, "    ports:\n      - \"%d:%d\"\n", port, port)
+	}
+	if contains(opts.Extras, "env") {
+		sb.WriteString("    env_file:\n      - .env\n")
+	}
+	if port != 0 {
+		sb.WriteString("    restart: unless-stopped\n")
+	}
+	return sb.String()
+}
+
+// libraryMatrix เวอร์ชันของรันไทม์ที่ CI ของ library ทดสอบ (library ถูกใช้จากโปรเจ็กต์ที่ใช้เวอร์ชันต่างกัน)
+var libraryMatrix = struct {
+	Go, Node []string
+}{
+	Go:   []string{"oldstable", "stable"},
+	Node: []string{"20", "22", "24"},
+}
+
+// ciWorkflowFor สร้าง GitHub Actions workflow ตามรันไทม์ของโปรเจ็กต์
+// library ทดสอบบน matrix ของเวอร์ชัน Go/Node แทนเวอร์ชันเดียว
+func ciWorkflowFor(opts ui.ProjectOptions) string {
+	library := opts.ProjectType == config.Library
+	var steps, matrix string
+	switch {
+	case strings.EqualFold(opts.Framework.Language, "Go") || strings.EqualFold(opts.Runtime, "go"):
+		goVersion := "go-version-file: go.mod"
+		if library {
+			matrix = "go: [" + quoteList(libraryMatrix.Go) + "]"
+			goVersion = "go-version: ${{ matrix.go }}"
+		}
+		steps = `      - uses: actions/setup-go@v5
+        with:
+          ` + goVersion + `
+      - run: go build ./...
+      - run: go vet ./...
+      - run: go test ./...
+`
+	case opts.Framework.Runtime == "java":
+		// ใช้ wrapper ที่ commit ไว้ในโปรเจ็กต์ (สร้างตอนติดตั้ง) เพื่อให้ CI ใช้ Gradle/Maven เวอร์ชันเดียวกับเครื่อง dev
+		build := "      - uses: gradle/actions/setup-gradle@v4\n      - run: ./gradlew build\n"
+		if opts.Variant == "maven" {
+			build = "      - run: ./mvnw -B verify\n"
+		}
+		steps = `      - uses: actions/setup-java@v4
+        with:
+          distribution: temurin
+          java-version: "21"
+` + build
+	case opts.Framework.Runtime == "rust":
+		steps = `      - uses: dtolnay/rust-toolchain@stable
+        with:
+          components: clippy
+      - run: cargo build
+      - run: cargo clippy -- -D warnings
+      - run: cargo test
+`
+	case opts.Framework.Runtime == "python":
+		steps = `      - uses: actions/setup-python@v5
+        with:
+          python-version: "3.12"
+      - run: pip install -e ".[dev]"
+      - run: pytest
+`
+	case opts.Framework.Runtime == "bun":
+		steps = `      - uses: oven-sh/setup-bun@v2
+      - run: bun install
+      - run: bun test
+`
+	case opts.Framework.Runtime == "deno":
+		steps = `      - uses: denoland/setup-deno@v2
+        with:
+          deno-version: v2.x
+      - run: deno install
+      - run: deno lint
+      - run: deno task test
+`
+	case opts.Framework.Runtime == "node" || opts.Framework.Runtime == "":
+		pm := strings.ToLower(opts.PackageManager)
+		if pm == "" {
+			pm = "npm"
+		}
+		setup := ""
+		switch pm {
+		case "pnpm":
+			setup = "      - uses: pnpm/action-setup@v4\n"
+		case "bun":
+			setup = "      - uses: oven-sh/setup-bun@v2\n"
+		}
+		nodeVersion := "20"
+		if library {
+			matrix = "node: [" + quoteList(libraryMatrix.Node) + "]"
+			nodeVersion = "${{ matrix.node }}"
+		}
+		steps = setup + fmt.Sprintf(`      - uses: actions/setup-node@v4
+        with:
+          node-version: %s
+      - run: %s
+      - run: %s
+      - run: %s
+`, nodeVersion, adaptCommand(pm, "npm install"), adaptCommand(pm, "npm run build --if-present"), adaptCommand(pm, "npm test --if-present"))
+	default:
+		steps = "      - run: echo \"เพิ่มขั้นตอน build/test ของโปรเจ็กต์ที่นี่\"\n"
+	}
+
+	return `name: CI
+
+on:
+  push:
+    branches: [main]
+  pull_request:
+
+jobs:
+  build:
+    runs-on: ubuntu-latest
+` + strategyFor(matrix) + `    steps:
+      - uses: actions/checkout@v4
+` + steps
+}
+
+// gitlabCIFor สร้าง .gitlab-ci.yml ตามรันไทม์ของโปรเจ็กต์ (ขั้นตอนเดียวกับ ciWorkflowFor)
+// library ฝั่ง Node ทดสอบบน matrix ของเวอร์ชัน ส่วน Go ใช้ image ล่าสุด เพราะ image ไม่มี tag oldstable/stable
+func gitlabCIFor(opts ui.ProjectOptions) string {
+	image := "alpine:latest"
+	var script []string
+	matrix := ""
+	switch {
+	case strings.EqualFold(opts.Framework.Language, "Go") || strings.EqualFold(opts.Runtime, "go"):
+		image = "golang:1"
+		script = []string{"go build ./...", "go vet ./...", "go test ./..."}
+	case opts.Framework.Runtime == "java":
+		image = "eclipse-temurin:21"
+		script = []string{"./gradlew build"}
+		if opts.Variant == "maven" {
+			script = []string{"./mvnw -B verify"}
+		}
+	case opts.Framework.Runtime == "rust":
+		image = "rust:1"
+		script = []string{"rustup component add clippy", "cargo build", "cargo clippy -- -D warnings", "cargo test"}
+	case opts.Framework.Runtime == "python":
+		image = "python:3.12"
+		script = []string{`pip install -e ".[dev]"`, "pytest"}
+	case opts.Framework.Runtime == "bun":
+		image = "oven/bun:1"
+		script = []string{"bun install", "bun test"}
+	case opts.Framework.Runtime == "deno":
+		image = "denoland/deno:2"
+		script = []string{"deno install", "deno lint", "deno task test"}
+	case opts.Framework.Runtime == "node" || opts.Framework.Runtime == "":
+		pm := strings.ToLower(opts.PackageManager)
+		if pm == "" {
+			pm = "npm"
+		}
+		image = "node:20"
+		if opts.ProjectType == config.Library {
+			matrix = "NODE_VERSION: [" + quoteList(libraryMatrix.Node) + "]"
+			image = "node:${NODE_VERSION}"
+		}
+		switch pm {
+		case "pnpm", "yarn":
+			script = append(script, "corepack enable")
+		case "bun":
+			script = append(script, "npm install -g bun")
+		}
+		script = append(script, adaptCommand(pm, "npm install"), adaptCommand(pm, "npm run build --if-present"), adaptCommand(pm, "npm test --if-present"))
+	default:
+		script = []string{`echo "เพิ่มขั้นตอน build/test ของโปรเจ็กต์ที่นี่"`}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`workflow:
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "merge_request_event"
+    - if: $CI_COMMIT_BRANCH == $CI_DEFAULT_BRANCH
+
+test:
+`)
+	fmt.Fprintf(&sb, "  image: %s\n", image)
+	if matrix != "" {
+		sb.WriteString("  parallel:\n    matrix:\n      - " + matrix + "\n")
+	}
+	sb.WriteString("  script:\n")
+	for _, line := range script {
+		fmt.Fprintf(&sb, "    - %s\n", line)
+	}
+	return sb.String()
+}
+
+// strategyFor ส่วน strategy ของ job (ว่างเมื่อไม่มี matrix)
+func strategyFor(matrix string) string {
+	if matrix == "" {
+		return ""
+	}
+	return "    strategy:\n      fail-fast: false\n      matrix:\n        " + matrix + "\n"
+}
+
+// quoteList ค่าใน matrix แบบมีเครื่องหมายคำพูด เช่น "20", "22" (YAML จะอ่าน 1.20 เป็นตัวเลขถ้าไม่ใส่)
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
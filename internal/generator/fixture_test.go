@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/ui"
+)
+
+// generateFixture สร้างโปรเจ็กต์จาก framework ในแค็ตตาล็อกด้วยเทมเพลตของ repository นี้ลงโฟลเดอร์ชั่วคราว
+// mutate ปรับ options ก่อนสร้าง (เช่น extras หรือคำสั่งติดตั้ง) คืนโฟลเดอร์ของโปรเจ็กต์และ config ที่ใช้สร้าง
+func generateFixture(t *testing.T, framework string, mutate func(*ui.ProjectOptions)) (string, *config.Config) {
+	t.Helper()
+	pterm.DisableOutput()
+	t.Cleanup(pterm.EnableOutput)
+
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.Defaults()
+	cfg.TemplateSources = []string{root}
+
+	fw, pt, ok := config.FindFramework(framework)
+	if !ok {
+		t.Fatalf("ไม่พบ framework %s", framework)
+	}
+	opts := ui.ProjectOptions{
+		Name:        "fixture",
+		ProjectType: pt,
+		Framework:   fw,
+		Runtime:     fw.Runtime,
+		License:     "MIT",
+	}
+	if mutate != nil {
+		mutate(&opts)
+	}
+	dir := filepath.Join(t.TempDir(), opts.Name)
+	if err := Generate(context.Background(), opts, cfg, GenerateOptions{Output: dir, Quiet: true}); err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	return dir, cfg
+}
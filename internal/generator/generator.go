@@ -4,6 +4,7 @@ package generator
 // ขั้นตอนหลัก: สร้างโฟลเดอร์, คัดลอกไฟล์เทมเพลต, เรนเดอร์ตัวแปร, และสร้างไฟล์พื้นฐาน
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -12,24 +13,68 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
 	"github.com/pterm/pterm"
 
+	"projgen/internal/config"
+	"projgen/internal/manifest"
+	"projgen/internal/naming"
+	genRuntime "projgen/internal/runtime"
+	"projgen/internal/templates"
 	"projgen/internal/ui"
 )
 
+// GenerateOptions ตัวเลือกของ Generate ที่ไม่ได้มาจากวิซาร์ด
+type GenerateOptions struct {
+	// Merge อนุญาตให้สร้างลงโฟลเดอร์ที่ไม่ว่าง โดยไฟล์ที่ชนกันจัดการตาม Conflict
+	Merge    bool
+	Conflict ConflictPolicy
+	// Resolve ถามผู้ใช้เมื่อ Conflict เป็น prompt (nil = เก็บไฟล์เดิมไว้)
+	Resolve ConflictResolver
+	// Output โฟลเดอร์ปลายทาง ("" = ./<ชื่อโปรเจ็กต์>, "." = สร้างในโฟลเดอร์ปัจจุบัน)
+	Output string
+	// Quiet ไม่แสดงขั้นตอนถัดไปหลังสร้างเสร็จ (ใช้ตอนสร้างหลาย service ที่สรุปรวมครั้งเดียว)
+	Quiet bool
+}
+
 // Generate ประมวลผลการสร้างโครงสร้างโปรเจ็กต์จากตัวเลือกของผู้ใช้
-func Generate(ctx context.Context, choices ui.ProjectOptions) error {
-	destDir, err := projectDirFromChoices(choices)
+// cfg ใช้กำหนดแหล่งค้นหาเทมเพลตและค่าเริ่มต้นอื่น ๆ
+func Generate(ctx context.Context, choices ui.ProjectOptions, cfg *config.Config, gopts GenerateOptions) error {
+	if err := naming.Validate(choices.Name, naming.EcosystemsFor(choices.Runtime, choices.Framework.Language)...); err != nil {
+		return err
+	}
+	destDir, err := projectDirFromChoices(choices, gopts.Output)
 	if err != nil {
 		return err
 	}
 
+	// ปรับคำสั่ง start/build ตามโครงสร้างหรือรันไทม์ที่เลือก แล้วแปลงคำสั่ง npm ให้ตรงกับ package manager ที่ตั้งค่าไว้
+	choices = applyVariant(choices)
+	if isMonorepo(choices) {
+		choices.PackageManager = monorepoPackageManager(choices)
+	}
+	choices = applyPackageManager(choices)
+	if strings.EqualFold(choices.Framework.Language, "Go") {
+		choices.GoModule = goModule(choices)
+	}
+	choices = prepareRuntime(ctx, choices)
+	choices = withFrontendBase(choices)
+	if choices.LicenseYear == 0 {
+		choices.LicenseYear = time.Now().Year()
+	}
+	if isMonorepo(choices) {
+		// บันทึกคำสั่งและพอร์ตของแต่ละแอปที่ปรับแล้วไว้ใน options (และ manifest) เพื่อให้ update เรนเดอร์ได้ผลเหมือนเดิม
+		web, api := monorepoApps(choices)
+		api = prepareRuntime(ctx, api)
+		choices.Frontend, choices.Backend = &web.Framework, &api.Framework
+	}
+
 	// ตรวจสอบโฟลเดอร์ปลายทาง
-	if err := ensureTargetDir(destDir); err != nil {
+	if err := ensureTargetDir(destDir, gopts.Merge); err != nil {
 		return err
 	}
 
@@ -38,27 +83,48 @@ func Generate(ctx context.Context, choices ui.ProjectOptions) error {
 	spinner, _ := pterm.DefaultSpinner.Start("📦 กำลังสร้างโปรเจ็กต์และไฟล์ที่จำเป็น...")
 
 	// 1) ค้นหาไดเรกทอรีเทมเพลต (ถ้ามี)
-	tmplDir := resolveTemplateDir(choices)
-
-	// 2) คัดลอก/เรนเดอร์ไฟล์จากเทมเพลต ถ้าพบ ไม่งั้นใช้ fallback
-	if tmplDir != "" {
-		if err := copyRenderTemplateDir(tmplDir, destDir, choices); err != nil {
-			spinner.Fail("คัดลอกไฟล์จากเทมเพลตล้มเหลว")
-			return fmt.Errorf("คัดลอกไฟล์จากเทมเพลตล้มเหลว: %w", err)
-		}
-	} else {
-		if err := generateFallbackSkeleton(destDir, choices); err != nil {
-			spinner.Fail("สร้างโครงสร้างพื้นฐานล้มเหลว")
-			return fmt.Errorf("สร้างโครงสร้างพื้นฐานล้มเหลว: %w", err)
+	tmplDir := resolveTemplateDir(choices, cfg.TemplateSources)
+	w := newWriter(destDir)
+	if gopts.Merge {
+		w.policy = gopts.Conflict
+		if w.policy == "" {
+			w.policy = PolicySkip
 		}
+		w.resolve = gopts.Resolve
+	}
+
+	// 2) คัดลอก/เรนเดอร์ไฟล์จากเทมเพลต ไม่พบเทมเพลตถือเป็นข้อผิดพลาด (ไม่สร้างโครงว่าง ๆ แทน)
+	if tmplDir == "" {
+		spinner.Fail("ไม่พบเทมเพลต")
+		return missingTemplateError(choices.Framework)
+	}
+	if err := renderProject(w, tmplDir, choices, cfg.TemplateSources); err != nil {
+		spinner.Fail("คัดลอกไฟล์จากเทมเพลตล้มเหลว")
+		return fmt.Errorf("คัดลอกไฟล์จากเทมเพลตล้มเหลว: %w", err)
 	}
 	spinner.Success("สร้างโครงสร้างโปรเจ็กต์เสร็จสิ้น")
 
 	// 3) สร้างไฟล์เสริมตาม Extras เช่น .env, Dockerfile, README.md
-	if err := generateExtras(destDir, choices); err != nil {
+	if err := generateExtras(w, choices); err != nil {
 		return err
 	}
 
+	// ถามผู้ใช้เรื่องไฟล์ที่ชนกับไฟล์เดิม (โหมด merge แบบ prompt) หลังสปินเนอร์หยุดแล้ว
+	if err := w.resolvePending(); err != nil {
+		return err
+	}
+	w.printConflicts()
+
+	// hash ของไฟล์ตามที่เรนเดอร์จากเทมเพลต ก่อนคำสั่งติดตั้งแก้ go.mod, package.json ฯลฯ
+	// เพื่อให้ตรงกับผลของ renderSnapshot ที่ status/update ใช้เปรียบเทียบ
+	rendered, err := manifest.HashFiles(w.root, w.files)
+	if err != nil {
+		return err
+	}
+
+	// git init ก่อนติดตั้ง dependencies เพื่อให้สคริปต์ติดตั้ง git hooks (เช่น husky) พบ repository
+	gitReady := choices.GitInit && initGitRepo(ctx, destDir, choices, cfg)
+
 	// 4) ติดตั้ง dependencies หากเลือกไว้
 	if choices.AutoInstall && choices.Framework.InstallCmd != "" {
 		spinner, _ = pterm.DefaultSpinner.Start("⬇️  กำลังติดตั้ง dependencies...")
@@ -70,6 +136,19 @@ func Generate(ctx context.Context, choices ui.ProjectOptions) error {
 		}
 	}
 
+	// monorepo: backend ภาษาอื่นไม่ได้อยู่ใน workspace ของ Node จึงต้องติดตั้งในโฟลเดอร์ของแอปเอง
+	if choices.AutoInstall && isMonorepo(choices) {
+		if _, api := monorepoApps(choices); wrapsBackend(api) && api.Framework.InstallCmd != "" {
+			spinner, _ = pterm.DefaultSpinner.Start(fmt.Sprintf("⬇️  กำลังติดตั้ง dependencies ของ %s...", apiDir))
+			if err := runCommandInDir(ctx, filepath.Join(destDir, filepath.FromSlash(apiDir)), api.Framework.InstallCmd); err != nil {
+				spinner.Warning(fmt.Sprintf("ติดตั้ง dependencies ของ %s ไม่สำเร็จ", apiDir))
+				pterm.Info.Printfln("   💡 คุณสามารถติดตั้งเองได้ด้วยคำสั่ง: %s", pterm.Cyan("cd "+apiDir+" && "+api.Framework.InstallCmd))
+			} else {
+				spinner.Success(fmt.Sprintf("ติดตั้ง dependencies ของ %s สำเร็จ", apiDir))
+			}
+		}
+	}
+
 	// 5) ติดตั้ง CSS Framework หากเลือกไว้
 	if choices.CSSFramework != nil && choices.CSSFramework.InstallCmd != "" {
 		spinner, _ = pterm.DefaultSpinner.Start(fmt.Sprintf("🎨 กำลังติดตั้ง %s...", choices.CSSFramework.DisplayName))
@@ -90,12 +169,121 @@ func Generate(ctx context.Context, choices ui.ProjectOptions) error {
 		}
 	}
 
-	printSuccessNextSteps(destDir, choices)
+	// 7) รันคำสั่งของตัวเลือกเสริม เช่น ติดตั้ง ESLint/Prettier
+	if choices.AutoInstall {
+		runExtraCommands(ctx, destDir, choices)
+	}
+
+	// 8) บันทึก manifest (.projgen.json) พร้อม hash ของไฟล์ที่เรนเดอร์ไว้ก่อนติดตั้ง และ hash หลังติดตั้งของไฟล์ที่คำสั่งติดตั้งแก้
+	installed, err := installedHashes(destDir, rendered)
+	if err != nil {
+		return err
+	}
+	if err := writeManifest(ctx, destDir, rendered, installed, w.extras, tmplDir, cfg.TemplateSources, choices); err != nil {
+		pterm.Warning.Printfln("บันทึก %s ไม่สำเร็จ: %v", manifest.FileName, err)
+	}
+
+	// 9) commit แรก (รวม manifest และ lockfile จากการติดตั้ง)
+	if gitReady {
+		commitInitial(ctx, destDir, choices, cfg)
+	}
+
+	if !gopts.Quiet {
+		printSuccessNextSteps(destDir, choices)
+	}
+	return nil
+}
+
+// writeManifest บันทึกข้อมูลการสร้างโปรเจ็กต์พร้อม hash ของไฟล์ที่ projgen เขียน (relative path -> hash)
+// hash หลังคำสั่งติดตั้ง และไฟล์ที่แต่ละ extra เขียน
+func writeManifest(ctx context.Context, dir string, files, installed map[string]string, extras map[string][]string, tmplDir string, sources []string, opts ui.ProjectOptions) error {
+	now := time.Now().UTC()
+	m := &manifest.Manifest{
+		ProjgenVersion: config.Version,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		Template:       manifest.Template{Path: opts.Framework.TemplatePath},
+		Options:        opts,
+		Files:          files,
+		Installed:      installed,
+		Extras:         extras,
+	}
+	if err := recordTemplates(ctx, m, tmplDir, sources); err != nil {
+		return err
+	}
+	return manifest.Write(dir, m)
+}
+
+// recordTemplates บันทึกที่มา commit และ hash ของเทมเพลตหลักและเทมเพลตของแอปย่อยลง manifest
+// update ใช้ commit เหล่านี้เรนเดอร์เทมเพลตเวอร์ชันเดิมเป็น base ของ three-way merge
+func recordTemplates(ctx context.Context, m *manifest.Manifest, tmplDir string, sources []string) error {
+	if tmplDir != "" {
+		t, err := templateRecord(ctx, m.Template.Path, tmplDir)
+		if err != nil {
+			return err
+		}
+		m.Template = t
+	}
+	m.Apps = nil
+	for _, fw := range appFrameworks(m.Options) {
+		dir := templates.Resolve(fw.TemplatePath, sources)
+		if dir == "" {
+			continue
+		}
+		t, err := templateRecord(ctx, fw.TemplatePath, dir)
+		if err != nil {
+			return err
+		}
+		m.Apps = append(m.Apps, t)
+	}
+	return nil
+}
+
+// templateRecord ที่มาของเทมเพลตในโฟลเดอร์ dir (path คือ path ในแค็ตตาล็อก)
+func templateRecord(ctx context.Context, path, dir string) (manifest.Template, error) {
+	t := manifest.Template{Path: path, Commit: templates.Commit(ctx, dir)}
+	t.Source, _ = filepath.Abs(dir)
+	var err error
+	t.Hash, err = templates.Hash(dir)
+	return t, err
+}
+
+// appFrameworks framework ของแอปย่อยที่เรนเดอร์จากเทมเพลตแยก: frontend/backend ของ monorepo หรือ frontend ฐานของ Tauri
+func appFrameworks(opts ui.ProjectOptions) []config.FrameworkOption {
+	opts = withFrontendBase(opts)
+	switch {
+	case isMonorepo(opts):
+		return []config.FrameworkOption{*opts.Frontend, *opts.Backend}
+	case opts.Framework.FrontendBase && opts.Frontend != nil:
+		return []config.FrameworkOption{*opts.Frontend}
+	}
 	return nil
 }
 
-// projectDirFromChoices กำหนดโฟลเดอร์ปลายทางจากชื่อโปรเจ็กต์ (ภายในโฟลเดอร์ปัจจุบัน)
-func projectDirFromChoices(opts ui.ProjectOptions) (string, error) {
+// installedHashes hash ปัจจุบันของไฟล์ที่คำสั่งติดตั้งแก้หลังเรนเดอร์ (เฉพาะไฟล์ที่ต่างจาก rendered)
+func installedHashes(dir string, rendered map[string]string) (map[string]string, error) {
+	rels := make([]string, 0, len(rendered))
+	for rel := range rendered {
+		rels = append(rels, rel)
+	}
+	current, err := manifest.HashFiles(dir, rels)
+	if err != nil {
+		return nil, err
+	}
+	installed := map[string]string{}
+	for rel, h := range current {
+		if h != rendered[rel] {
+			installed[rel] = h
+		}
+	}
+	if len(installed) == 0 {
+		return nil, nil
+	}
+	return installed, nil
+}
+
+// projectDirFromChoices กำหนดโฟลเดอร์ปลายทาง: output ที่ระบุ หรือ ./<ชื่อโปรเจ็กต์> ภายในโฟลเดอร์ปัจจุบัน
+func projectDirFromChoices(opts ui.ProjectOptions, output string) (string, error) {
 	if strings.TrimSpace(opts.Name) == "" {
 		return "", errors.New("จำเป็นต้องระบุชื่อโปรเจ็กต์")
 	}
@@ -103,12 +291,62 @@ func projectDirFromChoices(opts ui.ProjectOptions) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	// ใช้ชื่อโปรเจ็กต์เป็นโฟลเดอร์ปลายทาง
-	return filepath.Join(wd, toKebab(opts.Name)), nil
+	if strings.TrimSpace(output) == "" {
+		// ใช้ชื่อโปรเจ็กต์เป็นโฟลเดอร์ปลายทาง
+		return filepath.Join(wd, toKebab(opts.Name)), nil
+	}
+	return validateOutputDir(output)
 }
 
-// ensureTargetDir สร้างโฟลเดอร์ถ้ายังไม่มี และตรวจความว่างเปล่า
-func ensureTargetDir(dir string) error {
+// CheckOutputDir ตรวจโฟลเดอร์ปลายทางก่อนเริ่มวิซาร์ด เพื่อไม่ให้ผู้ใช้ตอบคำถามจนจบแล้วค่อยล้มเหลว
+// คืน absolute path ของโฟลเดอร์
+func CheckOutputDir(output string, merge bool) (string, error) {
+	dir, err := validateOutputDir(output)
+	if err != nil {
+		return "", err
+	}
+	if fi, err := os.Stat(dir); err == nil && fi.IsDir() && !merge {
+		empty, err := isDirEmpty(dir)
+		if err != nil {
+			return "", err
+		}
+		if !empty {
+			return "", fmt.Errorf("โฟลเดอร์ปลายทางไม่ว่างเปล่า: %s (ใช้ --merge หรือ --force เพื่อสร้างทับ)", dir)
+		}
+	}
+	return dir, nil
+}
+
+// validateOutputDir แปลง output เป็น absolute path และตรวจว่าใช้เป็นโฟลเดอร์โปรเจ็กต์ได้
+func validateOutputDir(output string) (string, error) {
+	dir, err := filepath.Abs(output)
+	if err != nil {
+		return "", err
+	}
+	if filepath.Dir(dir) == dir {
+		return "", fmt.Errorf("ไม่สามารถสร้างโปรเจ็กต์ที่ root ของระบบไฟล์: %s", dir)
+	}
+	if home, err := os.UserHomeDir(); err == nil && dir == filepath.Clean(home) {
+		return "", fmt.Errorf("ไม่สามารถสร้างโปรเจ็กต์ในโฟลเดอร์ home โดยตรง: %s", dir)
+	}
+	// โฟลเดอร์แม่ที่มีอยู่จริงใกล้ที่สุดต้องเป็นโฟลเดอร์ (ไม่ใช่ไฟล์)
+	for p := dir; ; p = filepath.Dir(p) {
+		fi, err := os.Stat(p)
+		if err == nil {
+			if !fi.IsDir() {
+				return "", fmt.Errorf("ปลายทางชนไฟล์ที่มีอยู่แล้ว: %s", p)
+			}
+			break
+		}
+		if filepath.Dir(p) == p {
+			break
+		}
+	}
+	return dir, nil
+}
+
+// ensureTargetDir สร้างโฟลเดอร์ถ้ายังไม่มี และตรวจความว่างเปล่า (ยกเว้นโหมด merge)
+func ensureTargetDir(dir string, merge bool) error {
 	if fi, err := os.Stat(dir); err == nil {
 		if !fi.IsDir() {
 			return fmt.Errorf("ปลายทางชนไฟล์ที่มีอยู่แล้ว: %s", dir)
@@ -117,8 +355,8 @@ func ensureTargetDir(dir string) error {
 		if err != nil {
 			return err
 		}
-		if !empty {
-			return fmt.Errorf("โฟลเดอร์ปลายทางไม่ว่างเปล่า: %s", dir)
+		if !empty && !merge {
+			return fmt.Errorf("โฟลเดอร์ปลายทางไม่ว่างเปล่า: %s (ใช้ --merge หรือ --force เพื่อสร้างทับ)", dir)
 		}
 		return nil
 	}
@@ -139,20 +377,59 @@ func isDirEmpty(name string) (bool, error) {
 }
 
 // resolveTemplateDir เลือกโฟลเดอร์เทมเพลตตามภาษา/เฟรมเวิร์กที่เลือก
-// ค้นหาใน ./templates เป็นหลัก หากไม่พบจะคืนสตริงว่างเพื่อใช้ fallback
-func resolveTemplateDir(opts ui.ProjectOptions) string {
-	// ใช้ TemplatePath จาก framework option โดยตรง
-	if opts.Framework.TemplatePath != "" {
-		// ตรวจสอบว่า path มีอยู่จริง
-		if _, err := os.Stat(opts.Framework.TemplatePath); err == nil {
-			return opts.Framework.TemplatePath
+// ค้นหาใน ./templates เป็นหลัก ตามด้วย template-sources จาก config
+// หากไม่พบจะคืนสตริงว่างเพื่อใช้ fallback
+func resolveTemplateDir(opts ui.ProjectOptions, sources []string) string {
+	return templates.Resolve(opts.Framework.TemplatePath, sources)
+}
+
+// CheckTemplates ตรวจว่าทุก framework ในแค็ตตาล็อกหาโฟลเดอร์เทมเพลตเจอ
+// คืน error ที่ระบุทุกรายการที่หาไม่เจอ เพื่อไม่ให้ผู้ใช้เลือกเทมเพลตที่ไม่มีอยู่จริง
+func CheckTemplates(sources []string) error {
+	all := config.AllFrameworks()
+	var missing []string
+	for _, fw := range all {
+		if templates.Resolve(fw.TemplatePath, sources) == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s)", fw.Name, fw.TemplatePath))
 		}
 	}
-	return ""
+	switch len(missing) {
+	case 0:
+		return nil
+	case len(all):
+		return errors.New("ไม่พบโฟลเดอร์เทมเพลต (templates/) — ตั้งค่า template-sources ให้ชี้ไปที่โฟลเดอร์เทมเพลต")
+	}
+	return fmt.Errorf("ไม่พบเทมเพลตของ %s — ตั้งค่า template-sources ให้ชี้ไปที่โฟลเดอร์เทมเพลต", strings.Join(missing, ", "))
+}
+
+func missingTemplateError(fw config.FrameworkOption) error {
+	return fmt.Errorf("ไม่พบเทมเพลต %s ของ %s (ตั้งค่า template-sources ให้ชี้ไปที่โฟลเดอร์เทมเพลต)", fw.TemplatePath, fw.Name)
+}
+
+// renderProject เรนเดอร์เทมเพลตของโปรเจ็กต์ และแอปย่อยใน apps/ เมื่อเป็น monorepo
+// เทมเพลตที่ต่อยอดจาก frontend (เช่น Tauri) จะเรนเดอร์เทมเพลต Vite ที่เลือกเป็นฐานก่อน
+func renderProject(w *writer, tmplDir string, opts ui.ProjectOptions, sources []string) error {
+	opts = withFrontendBase(opts)
+	if opts.Framework.FrontendBase && opts.Frontend != nil {
+		if err := renderFrontendBase(w, opts, sources); err != nil {
+			return err
+		}
+	}
+	if err := copyRenderTemplateDir(w, tmplDir, opts); err != nil {
+		return err
+	}
+	if isMonorepo(opts) {
+		return renderMonorepo(w, opts, sources)
+	}
+	if opts.Framework.FrontendBase && opts.Frontend != nil {
+		return addTauriToFrontend(w, opts)
+	}
+	return nil
 }
 
 // copyRenderTemplateDir เดินสำรวจไดเรกทอรีเทมเพลตและเรนเดอร์ไฟล์ลงปลายทาง
-func copyRenderTemplateDir(srcDir, destDir string, opts ui.ProjectOptions) error {
+// ไฟล์ใน _variants/<variant>/ ของรูปแบบที่เลือกจะถูกวางทับไฟล์หลัก
+func copyRenderTemplateDir(w *writer, srcDir string, opts ui.ProjectOptions) error {
 	data := map[string]any{
 		"Name":         opts.Name,
 		"Project":      opts.Name,
@@ -163,103 +440,142 @@ func copyRenderTemplateDir(srcDir, destDir string, opts ui.ProjectOptions) error
 		"Extras":       opts.Extras,
 		"Port":         defaultPort(opts),
 		"KebabName":    toKebab(opts.Name),
+		"Author":       opts.AuthorName,
+		"AuthorEmail":  opts.AuthorEmail,
+		"License":      opts.License,
+		"Module":       goModule(opts),
+		"Variant":      opts.Variant,
+		"AppID":        appID(opts),
+		"Package":      goPackageName(opts),
+		"Description":  libraryDescription(opts),
+		"Repository":   opts.Repository,
+	}
+	if opts.Frontend != nil {
+		data["Frontend"] = opts.Frontend.DisplayName
+	}
+	if isMonorepo(opts) {
+		web, api := monorepoApps(opts)
+		data["Frontend"], data["WebPort"] = web.Framework.DisplayName, defaultPort(web)
+		data["Backend"], data["ApiPort"] = api.Framework.DisplayName, defaultPort(api)
+		data["PackageManager"] = monorepoPackageManager(opts)
+	}
+
+	if err := renderTree(w, srcDir, data, opts); err != nil {
+		return err
+	}
+	if opts.Variant == "" {
+		return nil
 	}
+	// รูปแบบที่ไม่มีโฟลเดอร์ของตัวเอง (หรือเทมเพลตรุ่นก่อนมี variants) ใช้เฉพาะไฟล์หลัก
+	variantDir := filepath.Join(srcDir, variantsDir, opts.Variant)
+	if _, err := os.Stat(variantDir); err != nil {
+		return nil
+	}
+	return renderTree(w, variantDir, data, opts)
+}
 
+// renderTree เรนเดอร์ทุกไฟล์ใต้ srcDir (ยกเว้น _variants) ลงโปรเจ็กต์
+func renderTree(w *writer, srcDir string, data map[string]any, opts ui.ProjectOptions) error {
 	return filepath.WalkDir(srcDir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 		rel, _ := filepath.Rel(srcDir, path)
-		target := filepath.Join(destDir, rel)
 		if d.IsDir() {
-			return os.MkdirAll(target, 0o755)
+			if rel == variantsDir {
+				return filepath.SkipDir
+			}
+			return nil
 		}
 		// รองรับไฟล์ .tmpl -> ตัดนามสกุลเมื่อเรนเดอร์ (ปลอดภัยแม้ไม่มีนามสกุลนี้)
-		target = strings.TrimSuffix(target, ".tmpl")
+		// __name__ ใน path ถูกแทนด้วยชื่อโปรเจ็กต์ เช่น cmd/__name__/main.go
+		target := expandName(strings.TrimSuffix(filepath.ToSlash(rel), ".tmpl"), opts)
 		b, readErr := os.ReadFile(path)
 		if readErr != nil {
 			return readErr
 		}
 		// พยายามเรนเดอร์เป็น text/template เสมอ (เหมาะกับไฟล์ข้อความ)
-		if err := renderToFile(string(b), target, data); err != nil {
-			return err
+		out, err := renderTemplate(string(b), data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
 		}
-		return nil
+		return w.writeFile(target, out)
 	})
 }
 
-// generateFallbackSkeleton กรณีไม่มีเทมเพลต ให้สร้างไฟล์พื้นฐานขั้นต่ำ
-func generateFallbackSkeleton(destDir string, opts ui.ProjectOptions) error {
+// generateFallbackSkeleton สร้างไฟล์พื้นฐานขั้นต่ำ ใช้เฉพาะตอน update โปรเจ็กต์เก่าที่สร้างโดยไม่มีเทมเพลต
+func generateFallbackSkeleton(w *writer, opts ui.ProjectOptions) error {
 	// README.md
 	readme := fmt.Sprintf("# %s\n\nโปรเจ็กต์ที่สร้างด้วย projgen (โหมดพื้นฐาน)\n\nภาษา: %s\nเฟรมเวิร์ก: %s\nรันไทม์: %s\n", 
 		opts.Name, opts.Framework.Language, opts.Framework.DisplayName, opts.Runtime)
-	if err := os.WriteFile(filepath.Join(destDir, "README.md"), []byte(readme), 0o644); err != nil {
+	if err := w.writeFile("README.md", []byte(readme)); err != nil {
 		return err
 	}
 	// โครงสร้าง src ง่าย ๆ
-	if err := os.MkdirAll(filepath.Join(destDir, "src"), 0o755); err != nil {
-		return err
-	}
-	mainFile := filepath.Join(destDir, "src", "main.txt")
 	content := fmt.Sprintf("โปรเจ็กต์ %s สร้างเมื่อ %s", opts.Name, time.Now().Format(time.RFC3339))
-	if err := os.WriteFile(mainFile, []byte(content), 0o644); err != nil {
+	if err := w.writeFile("src/main.txt", []byte(content)); err != nil {
 		return err
 	}
 	return nil
 }
 
 // generateExtras สร้างไฟล์เสริมตามตัวเลือก
-func generateExtras(destDir string, opts ui.ProjectOptions) error {
-	// .env เสมอถ้าเลือก
-	if contains(opts.Extras, ".env") {
-		env := fmt.Sprintf("PORT=%d\nAPP_NAME=%s\n", defaultPort(opts), toKebab(opts.Name))
-		if err := os.WriteFile(filepath.Join(destDir, ".env"), []byte(env), 0o644); err != nil {
+// ไฟล์ที่เทมเพลตมีอยู่แล้วจะไม่ถูกเขียนทับ
+func generateExtras(w *writer, opts ui.ProjectOptions) error {
+	for _, selected := range opts.Extras {
+		ex, ok := config.FindExtra(selected)
+		// .gitignore รวมกับไฟล์ของเทมเพลตหลังสร้างไฟล์อื่นครบแล้ว (ต้องรู้ว่ามี Dockerfile หรือไม่)
+		if !ok || ex.Name == "gitignore" {
+			continue
+		}
+		if err := applyExtra(w, ex.Name, opts, nil); err != nil {
 			return err
 		}
 	}
-	// Dockerfile
-	if contains(opts.Extras, "Dockerfile") {
-		df := dockerfileFor(opts)
-		if err := os.WriteFile(filepath.Join(destDir, "Dockerfile"), []byte(df), 0o644); err != nil {
+	// git repository ต้องมี .gitignore เสมอ (ไม่อย่างนั้น node_modules หรือ .env จะติดไปกับ commit แรก)
+	if opts.GitInit || contains(opts.Extras, "gitignore") {
+		if err := applyGitignore(w, opts); err != nil {
 			return err
 		}
 	}
 	// README.md เสริม (ถ้ายังไม่มี)
-	readmePath := filepath.Join(destDir, "README.md")
-	if _, err := os.Stat(readmePath); os.IsNotExist(err) {
+	if !w.exists("README.md") {
 		content := fmt.Sprintf("# %s\n\nสร้างด้วย projgen\n", opts.Name)
-		if err := os.WriteFile(readmePath, []byte(content), 0o644); err != nil {
+		if err := w.writeFile("README.md", []byte(content)); err != nil {
 			return err
 		}
 	}
-	return nil
+	// LICENSE และฟิลด์ license ใน package.json / pyproject.toml / Cargo.toml
+	return applyLicense(w, opts)
 }
 
-func renderToFile(tpl string, dest string, data any) error {
-	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
-		return err
-	}
+// renderTemplate เรนเดอร์ข้อความเทมเพลตด้วย text/template และคืนผลลัพธ์เป็น bytes
+func renderTemplate(tpl string, data any) ([]byte, error) {
 	funcMap := template.FuncMap{
 		"ToLower": strings.ToLower,
 		"ToUpper": strings.ToUpper,
 		"Kebab":   toKebab,
+		"Quote":   strconv.Quote, // สตริงในเครื่องหมายคำพูดพร้อม escape ใช้ได้ทั้งใน JSON, TOML และ Go
 	}
 	t, err := template.New("file").Funcs(funcMap).Parse(tpl)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	f, err := os.Create(dest)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	if err := t.Execute(f, data); err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
 	}
-	return nil
+	return buf.Bytes(), nil
 }
 
+// defaultPort คืนพอร์ตที่แอปฟัง หรือ 0 เมื่อแอปไม่ใช่ server (เช่น CLI)
 func defaultPort(opts ui.ProjectOptions) int {
+	if opts.Framework.Port < 0 {
+		return 0
+	}
+	if opts.Framework.Port != 0 {
+		return opts.Framework.Port
+	}
 	if strings.EqualFold(opts.Framework.Language, "Go") {
 		return 8080
 	}
@@ -269,27 +585,180 @@ func defaultPort(opts ui.ProjectOptions) int {
 
 func dockerfileFor(opts ui.ProjectOptions) string {
 	if strings.EqualFold(opts.Framework.Language, "Go") || strings.EqualFold(opts.Runtime, "go") {
-		return fmt.Sprintf(`FROM golang:1.25.3-alpine AS builder
+		main := opts.Framework.MainPackage
+		if main == "" {
+			main = "."
+		}
+		build := fmt.Sprintf(`FROM golang:1.25.3-alpine AS builder
 WORKDIR /app
 COPY . .
-RUN go build -o app ./...
+RUN go build -o app %s
 
 FROM alpine:3.19
 WORKDIR /app
 COPY --from=builder /app/app /usr/local/bin/app
-EXPOSE %d
+`, main)
+		port := defaultPort(opts)
+		if port == 0 {
+			// CLI: ส่ง argument ของ docker run ต่อให้แอป
+			return build + `ENTRYPOINT ["/usr/local/bin/app"]
+`
+		}
+		return build + fmt.Sprintf(`EXPOSE %d
 CMD ["/usr/local/bin/app"]
-`, defaultPort(opts))
+`, port)
 	}
-	// Node/Bun/Deno (ใช้ Node เป็นค่าปกติ)
-	return fmt.Sprintf(`FROM node:20-alpine
+	if opts.Framework.Runtime == "java" {
+		builder := `FROM gradle:8.14-jdk21 AS builder
+WORKDIR /app
+COPY . .
+RUN gradle bootJar --no-daemon
+RUN java -Djarmode=tools -jar build/libs/app.jar extract --layers --launcher --destination extracted
+`
+		if opts.Variant == "maven" {
+			builder = `FROM maven:3.9-eclipse-temurin-21 AS builder
 WORKDIR /app
-COPY package*.json ./
-RUN npm ci || npm install
 COPY . .
+RUN mvn -B package -DskipTests
+RUN java -Djarmode=tools -jar target/app.jar extract --layers --launcher --destination extracted
+`
+		}
+		// แยก layer ของ jar เพื่อให้ Docker cache ชั้น dependencies ได้
+		return builder + fmt.Sprintf(`
+FROM eclipse-temurin:21-jre
+WORKDIR /app
+COPY --from=builder /app/extracted/dependencies/ ./
+COPY --from=builder /app/extracted/spring-boot-loader/ ./
+COPY --from=builder /app/extracted/snapshot-dependencies/ ./
+COPY --from=builder /app/extracted/application/ ./
 EXPOSE %d
-CMD ["npm","run","start"]
+ENTRYPOINT ["java", "org.springframework.boot.loader.launch.JarLauncher"]
 `, defaultPort(opts))
+	}
+	if opts.Framework.Runtime == "rust" {
+		// ชื่อไบนารีคือชื่อ package ใน Cargo.toml
+		// ทั้งสอง stage ใช้ Debian รุ่นเดียวกัน เพื่อให้ glibc ที่ไบนารีลิงก์ไว้มีใน image ที่รัน
+		return fmt.Sprintf(`FROM rust:1.90-slim-bookworm AS builder
+WORKDIR /app
+COPY . .
+RUN cargo build --release
+
+FROM debian:bookworm-slim
+COPY --from=builder /app/target/release/%s /usr/local/bin/app
+EXPOSE %d
+CMD ["/usr/local/bin/app"]
+`, toKebab(opts.Name), defaultPort(opts))
+	}
+	if opts.Framework.Runtime == "python" {
+		return fmt.Sprintf(`FROM python:3.12-slim
+ENV PYTHONDONTWRITEBYTECODE=1 PYTHONUNBUFFERED=1
+WORKDIR /app
+COPY . .
+RUN pip install --no-cache-dir .
+EXPOSE %d
+CMD %s
+`, defaultPort(opts), execForm(opts.Framework.ServeCmd))
+	}
+	if isMERN(opts) {
+		return mernDockerfile(opts)
+	}
+	return jsDockerfile(opts, "")
+}
+
+// jsDockerfile Dockerfile ของแอป Node/Bun/Deno
+// dir ว่าง = build context คือโฟลเดอร์ของแอป ส่วน monorepo ใช้ root ของ repo เป็น build context
+// (dir คือโฟลเดอร์ของแอปเทียบกับ root) และคัดลอก tsconfig.base.json ไว้ที่ตำแหน่งที่ tsconfig ของแอป extends
+// args คือ build arg ที่ส่งต่อเป็น environment ของขั้น build (เช่น API_URL ที่ Next.js ฝังไว้ใน rewrites ตอน build)
+func jsDockerfile(opts ui.ProjectOptions, dir string, args ...string) string {
+	workdir, src, ctx := "WORKDIR /app\n", "", "."
+	if dir != "" {
+		workdir = "WORKDIR /app\nCOPY tsconfig.base.json ./\nWORKDIR /app/" + dir + "\n"
+		src, ctx = dir+"/", dir+"/"
+	}
+	env := ""
+	for _, arg := range args {
+		env += "ARG " + arg + "\nENV " + arg + "=$" + arg + "\n"
+	}
+	switch opts.Framework.Runtime {
+	case "bun":
+		return fmt.Sprintf(`FROM oven/bun:1-alpine
+%sCOPY %spackage.json %sbun.lock* ./
+RUN bun install --production
+COPY %s .
+%sEXPOSE %d
+CMD ["bun","run","start"]
+`, workdir, src, src, ctx, env, defaultPort(opts))
+	case "deno":
+		// build ก่อนถ้า deno.json มี task build (เช่น Fresh)
+		build := ""
+		if opts.Framework.BuildCmd != "" {
+			build = "RUN " + opts.Framework.BuildCmd + "\n"
+		}
+		return fmt.Sprintf(`FROM denoland/deno:2.5.4
+%sCOPY %s .
+RUN deno install
+%s%sEXPOSE %d
+CMD ["deno","task","start"]
+`, workdir, ctx, env, build, defaultPort(opts))
+	}
+	// Node (ค่าปกติ) framework ที่ระบุ ServeCmd (เช่น Vite, Next.js) ต้อง build ก่อนรัน
+	// image มีแค่ npm จึงใช้ npm run build แทน BuildCmd ที่อาจถูกแปลงเป็น package manager อื่นแล้ว
+	build, serve := "", `["npm","run","start"]`
+	if opts.Framework.ServeCmd != "" {
+		if opts.Framework.BuildCmd != "" {
+			build = "RUN npm run build\n"
+		}
+		serve = execForm(opts.Framework.ServeCmd)
+	}
+	return fmt.Sprintf(`FROM node:20-alpine
+%sCOPY %spackage*.json ./
+RUN npm ci || npm install
+COPY %s .
+%s%sEXPOSE %d
+CMD %s
+`, workdir, src, ctx, env, build, defaultPort(opts), serve)
+}
+
+// splitArgs แยก arguments ด้วยช่องว่าง โดยข้อความในเครื่องหมายคำพูด ("..." หรือ '...') นับเป็น argument เดียว
+// ทำให้คำสั่งที่แสดงให้ผู้ใช้คัดลอกไปรันใน shell ได้ (เช่น pip install -e ".[dev]") ใช้รันตรง ๆ ได้ด้วย
+func splitArgs(s string) []string {
+	var args []string
+	var sb strings.Builder
+	var quote rune
+	inArg := false
+	for _, r := range s {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			sb.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, sb.String())
+				sb.Reset()
+				inArg = false
+			}
+		default:
+			sb.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, sb.String())
+	}
+	return args
+}
+
+// execForm แปลงคำสั่งเป็นรูปแบบ JSON array ของ Dockerfile เช่น ["gunicorn","app:app"]
+func execForm(cmdStr string) string {
+	quoted := make([]string, 0, 4)
+	for _, f := range strings.Fields(cmdStr) {
+		quoted = append(quoted, strconv.Quote(f))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
 }
 
 func contains(list []string, s string) bool {
@@ -301,6 +770,21 @@ func contains(list []string, s string) bool {
 	return false
 }
 
+// appID identifier แบบ reverse-DNS ของแอปมือถือ/เดสก์ท็อป เช่น com.myapp (bundle ID ของ iOS, package ของ Android, identifier ของ Tauri)
+// แต่ละส่วนใช้ได้เฉพาะ a-z และ 0-9 และต้องขึ้นต้นด้วยตัวอักษร
+func appID(opts ui.ProjectOptions) string {
+	id := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(opts.Name))
+	if id == "" || (id[0] >= '0' && id[0] <= '9') {
+		id = "app" + id
+	}
+	return "com." + id
+}
+
 func toKebab(s string) string {
 	s = strings.TrimSpace(s)
 	s = strings.ReplaceAll(s, "_", "-")
@@ -316,6 +800,16 @@ func toKebab(s string) string {
 // แสดงข้อความสำเร็จและขั้นตอนถัดไปแบบเป็นมิตร
 func printSuccessNextSteps(destDir string, opts ui.ProjectOptions) {
 	projectDirName := filepath.Base(destDir)
+	// path สำหรับคำสั่ง cd: สั้นที่สุดระหว่าง relative กับ absolute และว่างเมื่อสร้างในโฟลเดอร์ปัจจุบัน
+	cdPath := destDir
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, destDir); err == nil && len(rel) < len(destDir) {
+			cdPath = rel
+		}
+	}
+	if cdPath == "." {
+		cdPath = ""
+	}
 	
 	pterm.Println()
 	pterm.Println(pterm.LightCyan("═══════════════════════════════════════════════════════════"))
@@ -330,7 +824,7 @@ func printSuccessNextSteps(destDir string, opts ui.ProjectOptions) {
 	pterm.Println(successBox)
 
 	// Next steps
-	cmds := nextCommands(projectDirName, opts)
+	cmds := nextCommands(cdPath, opts)
 	pterm.Println()
 	pterm.DefaultSection.WithStyle(pterm.NewStyle(pterm.FgLightCyan)).Println("� ขั้นตอนถัดไป")
 	
@@ -346,8 +840,12 @@ func printSuccessNextSteps(destDir string, opts ui.ProjectOptions) {
 	pterm.Println()
 }
 
+// nextCommands คำสั่งแนะนำหลังสร้างโปรเจ็กต์ (ไม่มี cd เมื่อ dir ว่าง คือสร้างในโฟลเดอร์ปัจจุบัน)
 func nextCommands(dir string, opts ui.ProjectOptions) []string {
-	cmds := []string{fmt.Sprintf("cd %s", dir)}
+	var cmds []string
+	if dir != "" {
+		cmds = append(cmds, fmt.Sprintf("cd %s", dir))
+	}
 	// แนะนำคำสั่งรันเริ่มต้นตามภาษาหรือรันไทม์
 	if strings.EqualFold(opts.Framework.Language, "Go") || strings.EqualFold(opts.Runtime, "go") {
 		if opts.Framework.StartCmd != "" {
@@ -386,6 +884,63 @@ func nextCommands(dir string, opts ui.ProjectOptions) []string {
 	return cmds
 }
 
+// runExtraCommands รันคำสั่งของ extras ที่เลือกไว้ทีละรายการ (ล้มเหลวแล้วแจ้งเตือนแต่ไม่หยุด)
+func runExtraCommands(ctx context.Context, destDir string, opts ui.ProjectOptions) {
+	for _, selected := range opts.Extras {
+		ex, ok := config.FindExtra(selected)
+		if !ok {
+			continue
+		}
+		cmdStr := extraCommand(ex, opts)
+		if cmdStr == "" {
+			continue
+		}
+		spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("🔧 กำลังติดตั้ง %s...", ex.DisplayName))
+		if err := runCommandInDir(ctx, destDir, cmdStr); err != nil {
+			spinner.Warning(fmt.Sprintf("ติดตั้ง %s ไม่สำเร็จ", ex.DisplayName))
+			pterm.Info.Printfln("   💡 คุณสามารถติดตั้งเองได้ด้วยคำสั่ง: %s", pterm.Cyan(cmdStr))
+		} else {
+			spinner.Success(fmt.Sprintf("ติดตั้ง %s สำเร็จ", ex.DisplayName))
+		}
+	}
+}
+
+// prepareRuntime ตรวจเวอร์ชันขั้นต่ำของรันไทม์ (Framework.MinVersion) และปรับคำสั่งของ framework ให้ตรงกับเครื่อง
+// ตรวจไม่ผ่านจะแจ้งเตือนแต่ยังสร้างไฟล์ต่อได้ (ขั้นติดตั้ง dependencies จะบอกคำสั่งให้รันเอง)
+func prepareRuntime(ctx context.Context, opts ui.ProjectOptions) ui.ProjectOptions {
+	if opts.Framework.Runtime == "python" {
+		return preparePython(ctx, opts)
+	}
+	if opts.Framework.MinVersion != "" {
+		requireRuntime(ctx, opts.Framework.Runtime, opts.Framework.MinVersion)
+	}
+	// เครื่องมือเพิ่มเติมของ framework เช่น cargo สำหรับ Tauri
+	for _, tool := range opts.Framework.Toolchains {
+		requireRuntime(ctx, tool, "")
+	}
+	// Spring Boot: ต้องมี gradle/mvn บนเครื่องเพื่อสร้าง wrapper (gradlew/mvnw) ครั้งแรก
+	if opts.Framework.Runtime == "java" {
+		tool := "gradle"
+		if opts.Variant == "maven" {
+			tool = "mvn"
+		}
+		requireRuntime(ctx, tool, "")
+	}
+	return opts
+}
+
+// requireRuntime คืนชื่อไบนารีของรันไทม์ที่เวอร์ชันไม่ต่ำกว่า min หรือสตริงว่างพร้อมแจ้งเตือนและวิธีติดตั้ง
+func requireRuntime(ctx context.Context, name, min string) string {
+	bin, _, err := genRuntime.Require(ctx, name, min)
+	if err != nil {
+		pterm.Warning.Println(err.Error())
+		for _, tip := range genRuntime.InstallTips(name) {
+			pterm.Info.Println(tip)
+		}
+	}
+	return bin
+}
+
 // installDependencies ติดตั้ง dependencies หลัก
 func installDependencies(ctx context.Context, destDir string, opts ui.ProjectOptions) error {
 	if opts.Framework.InstallCmd == "" {
@@ -411,18 +966,24 @@ func installUILibrary(ctx context.Context, destDir string, opts ui.ProjectOption
 }
 
 // runCommandInDir รันคำสั่งใน directory ที่ระบุ
+// รองรับหลายคำสั่งที่เชื่อมด้วย && โดยรันตามลำดับและหยุดเมื่อคำสั่งใดล้มเหลว
 func runCommandInDir(ctx context.Context, dir string, cmdStr string) error {
-	// แยกคำสั่งและ arguments
-	parts := strings.Fields(cmdStr)
-	if len(parts) == 0 {
-		return fmt.Errorf("คำสั่งว่างเปล่า")
+	for _, step := range strings.Split(cmdStr, "&&") {
+		// แยกคำสั่งและ arguments
+		parts := splitArgs(step)
+		if len(parts) == 0 {
+			return fmt.Errorf("คำสั่งว่างเปล่า")
+		}
+
+		cmd := exec.CommandContext(ctx, parts[0], parts[1:]...)
+		cmd.Dir = dir
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%s: %w", strings.TrimSpace(step), err)
+		}
 	}
-	
-	cmd := exec.CommandContext(ctx, parts[0], parts[1:]...)
-	cmd.Dir = dir
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	
-	return cmd.Run()
+	return nil
 }
 
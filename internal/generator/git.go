@@ -0,0 +1,112 @@
+package generator
+
+// git repository ของโปรเจ็กต์ที่สร้าง: git init (ก่อนติดตั้ง dependencies เพื่อให้สคริปต์ที่ติดตั้ง git hooks
+// เช่น husky ใน prepare ทำงานได้) และ commit แรกหลังบันทึก manifest
+// ทุกขั้นตอนเป็น best effort: ไม่มี git หรือคำสั่งล้มเหลวจะแสดงคำเตือนแทนการทำให้การสร้างโปรเจ็กต์ล้มเหลว
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/ui"
+)
+
+// initGitRepo สร้าง repository ใน dir ตั้ง branch เริ่มต้นและ remote origin
+// คืน false เมื่อข้าม (ไม่มี git หรือ dir อยู่ใน repository อื่นอยู่แล้ว) หรือ git init ล้มเหลว
+func initGitRepo(ctx context.Context, dir string, opts ui.ProjectOptions, cfg *config.Config) bool {
+	if _, err := exec.LookPath("git"); err != nil {
+		pterm.Warning.Println("ไม่พบคำสั่ง git — ข้ามการสร้าง git repository")
+		return false
+	}
+	// เช่น สร้าง service ใน monorepo ที่เป็น repository อยู่แล้ว ไม่ควรมี repository ซ้อนกัน
+	if out, err := runGit(ctx, dir, "rev-parse", "--is-inside-work-tree"); err == nil && out == "true" {
+		pterm.Info.Println("โฟลเดอร์อยู่ใน git repository อยู่แล้ว — ข้าม git init")
+		return false
+	}
+	if _, err := runGit(ctx, dir, "init", "--quiet"); err != nil {
+		pterm.Warning.Printfln("สร้าง git repository ไม่สำเร็จ: %v", err)
+		return false
+	}
+	// symbolic-ref แทน init -b เพื่อรองรับ git รุ่นก่อน 2.28
+	if branch := strings.TrimSpace(cfg.GitBranch); branch != "" {
+		if _, err := runGit(ctx, dir, "symbolic-ref", "HEAD", "refs/heads/"+branch); err != nil {
+			pterm.Warning.Printfln("ตั้งชื่อ branch %q ไม่สำเร็จ: %v", branch, err)
+		}
+	}
+	if opts.GitRemote != "" {
+		if _, err := runGit(ctx, dir, "remote", "add", "origin", opts.GitRemote); err != nil {
+			pterm.Warning.Printfln("เพิ่ม remote origin ไม่สำเร็จ: %v", err)
+		}
+	}
+	return true
+}
+
+// commitInitial commit ไฟล์ทั้งหมดเป็น commit แรก ชื่อ/อีเมลผู้เขียนจาก config ถูกใช้เมื่อตั้งไว้
+// (ค่าจาก git config ของผู้ใช้ git ใช้เองอยู่แล้ว)
+func commitInitial(ctx context.Context, dir string, opts ui.ProjectOptions, cfg *config.Config) {
+	if _, err := runGit(ctx, dir, "add", "--all"); err != nil {
+		pterm.Warning.Printfln("git add ไม่สำเร็จ: %v", err)
+		return
+	}
+	var args []string
+	if opts.AuthorName != "" {
+		args = append(args, "-c", "user.name="+opts.AuthorName)
+	}
+	if opts.AuthorEmail != "" {
+		args = append(args, "-c", "user.email="+opts.AuthorEmail)
+	}
+	msg := cfg.GitCommitMsg
+	if strings.TrimSpace(msg) == "" {
+		msg = "Initial commit"
+	}
+	// --no-verify: hook ที่ติดตั้งระหว่าง install (เช่น lint-staged, commitlint) ไม่ควรทำให้ commit แรกของโครงจากเทมเพลตล้มเหลว
+	args = append(args, "commit", "--quiet", "--no-verify", "-m", msg)
+	if _, err := runGit(ctx, dir, args...); err != nil {
+		pterm.Warning.Printfln("สร้าง commit แรกไม่สำเร็จ: %v", err)
+		pterm.Info.Printfln("   💡 ตั้งชื่อผู้เขียนด้วย %s แล้ว commit เองได้ด้วย %s",
+			pterm.Cyan("projgen config set author.name \"<ชื่อ>\""), pterm.Cyan("git commit -m \""+msg+"\""))
+		return
+	}
+	pterm.Success.Printfln("สร้าง git repository พร้อม commit แรก: %s", msg)
+	if opts.GitRemote != "" {
+		pterm.Info.Printfln("   💡 push ขึ้น remote ด้วยคำสั่ง: %s", pterm.Cyan("git push -u origin HEAD"))
+	}
+}
+
+// gitRemote URL ของ remote origin ของ repository ชื่อ name: git.remote หรือ <git.remote-prefix>/<name>.git
+func gitRemote(cfg *config.Config, name string) string {
+	if cfg.GitRemote != "" {
+		return cfg.GitRemote
+	}
+	if cfg.GitRemotePrefix != "" {
+		return cfg.GitRemotePrefix + "/" + name + ".git"
+	}
+	return ""
+}
+
+// runGit รันคำสั่ง git ใน dir และคืน stdout ข้อผิดพลาดมีข้อความจาก stderr
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// ชื่อคำสั่งย่อยอยู่หลังตัวเลือก -c <key>=<value>
+		sub := args[0]
+		for i := 0; i+2 < len(args) && args[i] == "-c"; i += 2 {
+			sub = args[i+2]
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", sub, msg)
+		}
+		return "", fmt.Errorf("git %s: %w", sub, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
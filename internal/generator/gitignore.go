@@ -0,0 +1,99 @@
+package generator
+
+// .gitignore ที่ประกอบจาก fragment (internal/gitignore) ตามรันไทม์ framework และเครื่องมือของโปรเจ็กต์
+// แล้วรวมกับ .gitignore ที่เทมเพลตมีอยู่แล้ว (หรือไฟล์เดิมในโฟลเดอร์) โดยไม่เพิ่ม pattern ซ้ำ
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"projgen/internal/config"
+	"projgen/internal/gitignore"
+	"projgen/internal/ui"
+)
+
+// applyGitignore เขียน .gitignore ที่รวม fragment ของโปรเจ็กต์เข้ากับไฟล์ที่เทมเพลตเขียนในรอบนี้หรือไฟล์เดิมในโฟลเดอร์
+func applyGitignore(w *writer, opts ui.ProjectOptions) error {
+	base, ok := w.read(".gitignore")
+	if !ok {
+		base, _ = os.ReadFile(filepath.Join(w.root, filepath.FromSlash(w.full(".gitignore"))))
+	}
+	docker := hasDocker(opts) || w.exists("Dockerfile") || w.exists("docker-compose.yml")
+	content := gitignore.Compose(string(base), gitignoreFragments(opts, docker)...)
+	if content == string(base) {
+		return nil
+	}
+	return w.writeFile(".gitignore", []byte(content))
+}
+
+// gitignoreFragments ชื่อ fragment ของโปรเจ็กต์: รันไทม์และ framework ของทุกแอป (monorepo มี web/api,
+// Tauri มีเทมเพลต Vite เป็นฐาน) ตามด้วย env, ide และ docker เมื่อโปรเจ็กต์มี Dockerfile/compose
+func gitignoreFragments(opts ui.ProjectOptions, docker bool) []string {
+	apps := []ui.ProjectOptions{opts}
+	if isMonorepo(opts) {
+		web, api := monorepoApps(opts)
+		apps = append(apps, web, api)
+	}
+	frameworks := []config.FrameworkOption{opts.Framework}
+	if opts.Frontend != nil {
+		frameworks = append(frameworks, *opts.Frontend)
+	}
+	if opts.Backend != nil {
+		frameworks = append(frameworks, *opts.Backend)
+	}
+
+	var names []string
+	add := func(name string) {
+		if name != "" && !contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	for _, app := range apps {
+		add(runtimeFragment(app))
+	}
+	for _, fw := range frameworks {
+		add(frameworkFragment(fw))
+	}
+	add("env")
+	add("ide")
+	if docker {
+		add("docker")
+	}
+	return names
+}
+
+// runtimeFragment fragment ของรันไทม์ (Bun และ Deno ใช้ของ Node เพราะมี node_modules เหมือนกัน)
+func runtimeFragment(opts ui.ProjectOptions) string {
+	switch {
+	case strings.EqualFold(opts.Framework.Language, "Go") || strings.EqualFold(opts.Runtime, "go"):
+		return "go"
+	case opts.Framework.Runtime == "java":
+		return "java"
+	case opts.Framework.Runtime == "rust":
+		return "rust"
+	case opts.Framework.Runtime == "python":
+		return "python"
+	default:
+		return "node"
+	}
+}
+
+// frameworkFragment fragment เฉพาะ framework (ว่างเมื่อ framework ไม่มีไฟล์ build ของตัวเอง)
+func frameworkFragment(fw config.FrameworkOption) string {
+	switch {
+	case fw.Name == "nextjs-ts" || fw.Name == "t3-stack":
+		return "next"
+	case fw.Name == "nestjs-api":
+		return "nest"
+	case strings.HasPrefix(fw.Name, "vite-"):
+		return "vite"
+	default:
+		return ""
+	}
+}
+
+// hasDocker ตรวจว่าเลือก extra ของ Docker ไว้หรือไม่
+func hasDocker(opts ui.ProjectOptions) bool {
+	return contains(opts.Extras, "dockerfile") || contains(opts.Extras, "docker-compose")
+}
@@ -0,0 +1,137 @@
+package generator
+
+// ไฟล์ LICENSE จากข้อความที่ bundle ไว้ (internal/license) และฟิลด์ license ใน manifest ของแพ็กเกจ
+// (package.json, pyproject.toml, Cargo.toml) ที่เทมเพลตสร้างในรอบนี้ ให้ตรงกับ license ที่เลือก
+
+import (
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"projgen/internal/license"
+	"projgen/internal/ui"
+)
+
+var (
+	jsonLicenseRe    = regexp.MustCompile(`(?m)^  "license": "[^"]*"`)
+	jsonVersionRe    = regexp.MustCompile(`(?m)^  "version": "[^"]*",\n`)
+	jsonNameRe       = regexp.MustCompile(`(?m)^  "name": "[^"]*",\n`)
+	tomlLicenseRe    = regexp.MustCompile(`(?m)^license(-file)? *=.*$`)
+	tomlVersionRe    = regexp.MustCompile(`(?m)^version *=.*\n`)
+	tomlNextHeaderRe = regexp.MustCompile(`(?m)^\[`)
+)
+
+// applyLicense เขียน LICENSE (ถ้าเทมเพลตไม่มีของตัวเอง) และตั้งค่า license ใน manifest ของแพ็กเกจทุกไฟล์ที่เขียนในรอบนี้
+// license ที่ไม่มีข้อความใน bundle (เช่น ISC) ยังถูกใส่ใน manifest แต่ไม่สร้าง LICENSE
+func applyLicense(w *writer, opts ui.ProjectOptions) error {
+	if opts.License == "" {
+		return nil
+	}
+	lic, known := license.Find(opts.License)
+	if known && !w.wrote("LICENSE") {
+		text, err := lic.Render(licenseYear(opts), licenseHolder(opts))
+		if err != nil {
+			return err
+		}
+		if err := w.writeFile("LICENSE", text); err != nil {
+			return err
+		}
+	}
+	npm, spdx := opts.License, opts.License
+	if known {
+		npm, spdx = lic.NPM(), lic.SPDX()
+	}
+
+	for _, rel := range append([]string(nil), w.files...) {
+		b, ok := w.read(rel)
+		if !ok {
+			continue
+		}
+		var patched string
+		switch path.Base(rel) {
+		case "package.json":
+			patched = setJSONLicense(string(b), npm)
+		case "pyproject.toml":
+			patched = setTOMLLicense(string(b), "project", "license = "+strconv.Quote(spdx))
+		case "Cargo.toml":
+			field := "license = " + strconv.Quote(spdx)
+			if known && lic.ID == license.Proprietary {
+				// Cargo รับเฉพาะ SPDX expression ใน license แพ็กเกจที่สงวนสิทธิ์จึงชี้ไปที่ไฟล์ LICENSE แทน
+				field = "license-file = " + strconv.Quote(licenseFileFrom(rel))
+			}
+			patched = setTOMLLicense(string(b), "package", field)
+		default:
+			continue
+		}
+		if patched != string(b) {
+			if err := w.writeFile(rel, []byte(patched)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// licenseYear ปีในบรรทัด copyright (manifest รุ่นเก่าไม่มีค่านี้ ใช้ปีปัจจุบัน)
+func licenseYear(opts ui.ProjectOptions) int {
+	if opts.LicenseYear != 0 {
+		return opts.LicenseYear
+	}
+	return time.Now().Year()
+}
+
+// licenseHolder เจ้าของลิขสิทธิ์: ชื่อผู้เขียนจาก config/git config หรือ "the <name> authors" เมื่อไม่ได้ตั้งไว้
+func licenseHolder(opts ui.ProjectOptions) string {
+	if name := strings.TrimSpace(opts.AuthorName); name != "" {
+		return name
+	}
+	return "the " + toKebab(opts.Name) + " authors"
+}
+
+// setJSONLicense แทนค่า "license" ระดับบนสุดของ package.json หรือเพิ่มต่อจาก "version" (หรือ "name")
+func setJSONLicense(s, value string) string {
+	field := `  "license": ` + strconv.Quote(value)
+	if loc := jsonLicenseRe.FindStringIndex(s); loc != nil {
+		return s[:loc[0]] + field + s[loc[1]:]
+	}
+	for _, re := range []*regexp.Regexp{jsonVersionRe, jsonNameRe} {
+		if loc := re.FindStringIndex(s); loc != nil {
+			return s[:loc[1]] + field + ",\n" + s[loc[1]:]
+		}
+	}
+	return s
+}
+
+// setTOMLLicense แทนบรรทัด license/license-file ในตาราง [section] หรือเพิ่มต่อจากบรรทัด version
+func setTOMLLicense(s, section, field string) string {
+	header := "[" + section + "]\n"
+	start := strings.Index(s, header)
+	if start < 0 {
+		return s
+	}
+	start += len(header)
+	end := len(s)
+	if loc := tomlNextHeaderRe.FindStringIndex(s[start:]); loc != nil {
+		end = start + loc[0]
+	}
+	body := s[start:end]
+	if loc := tomlLicenseRe.FindStringIndex(body); loc != nil {
+		body = body[:loc[0]] + field + body[loc[1]:]
+	} else if loc := tomlVersionRe.FindStringIndex(body); loc != nil {
+		body = body[:loc[1]] + field + "\n" + body[loc[1]:]
+	} else {
+		return s
+	}
+	return s[:start] + body + s[end:]
+}
+
+// licenseFileFrom path ของ LICENSE ที่ root เทียบกับโฟลเดอร์ของไฟล์ rel (เช่น src-tauri/Cargo.toml -> ../LICENSE)
+func licenseFileFrom(rel string) string {
+	dir := path.Dir(rel)
+	if dir == "." {
+		return "LICENSE"
+	}
+	return strings.Repeat("../", strings.Count(dir, "/")+1) + "LICENSE"
+}
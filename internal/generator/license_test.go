@@ -0,0 +1,130 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"projgen/internal/ui"
+)
+
+func TestSetJSONLicense(t *testing.T) {
+	tests := []struct {
+		name, in, want string
+	}{
+		{
+			name: "replace",
+			in:   "{\n  \"name\": \"app\",\n  \"license\": \"ISC\",\n  \"version\": \"1.0.0\"\n}\n",
+			want: "{\n  \"name\": \"app\",\n  \"license\": \"MIT\",\n  \"version\": \"1.0.0\"\n}\n",
+		},
+		{
+			name: "insert after version",
+			in:   "{\n  \"name\": \"app\",\n  \"version\": \"1.0.0\",\n  \"private\": true\n}\n",
+			want: "{\n  \"name\": \"app\",\n  \"version\": \"1.0.0\",\n  \"license\": \"MIT\",\n  \"private\": true\n}\n",
+		},
+		{
+			name: "insert after name",
+			in:   "{\n  \"name\": \"app\",\n  \"private\": true\n}\n",
+			want: "{\n  \"name\": \"app\",\n  \"license\": \"MIT\",\n  \"private\": true\n}\n",
+		},
+		{
+			name: "nested license is not top-level",
+			in:   "{\n  \"name\": \"app\",\n  \"version\": \"1.0.0\",\n  \"meta\": {\n    \"license\": \"ISC\"\n  }\n}\n",
+			want: "{\n  \"name\": \"app\",\n  \"version\": \"1.0.0\",\n  \"license\": \"MIT\",\n  \"meta\": {\n    \"license\": \"ISC\"\n  }\n}\n",
+		},
+		{
+			name: "no anchor",
+			in:   "{\n  \"private\": true\n}\n",
+			want: "{\n  \"private\": true\n}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setJSONLicense(tt.in, "MIT"); got != tt.want {
+				t.Errorf("setJSONLicense() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTOMLLicense(t *testing.T) {
+	tests := []struct {
+		name, in, section, field, want string
+	}{
+		{
+			name:    "pyproject replace",
+			in:      "[project]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"MIT\"\n\n[tool.pytest]\nlicense = \"keep\"\n",
+			section: "project",
+			field:   `license = "Apache-2.0"`,
+			want:    "[project]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"Apache-2.0\"\n\n[tool.pytest]\nlicense = \"keep\"\n",
+		},
+		{
+			name:    "insert after version",
+			in:      "[package]\nname = \"app\"\nversion = \"0.1.0\"\nedition = \"2021\"\n",
+			section: "package",
+			field:   `license = "MIT"`,
+			want:    "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"MIT\"\nedition = \"2021\"\n",
+		},
+		{
+			name:    "cargo proprietary replaces license with license-file",
+			in:      "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"MIT\"\n\n[dependencies]\n",
+			section: "package",
+			field:   `license-file = "../LICENSE"`,
+			want:    "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense-file = \"../LICENSE\"\n\n[dependencies]\n",
+		},
+		{
+			name:    "cargo license-file back to SPDX",
+			in:      "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense-file = \"LICENSE\"\n",
+			section: "package",
+			field:   `license = "MIT"`,
+			want:    "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"MIT\"\n",
+		},
+		{
+			name:    "missing section",
+			in:      "[workspace]\nmembers = []\n",
+			section: "package",
+			field:   `license = "MIT"`,
+			want:    "[workspace]\nmembers = []\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := setTOMLLicense(tt.in, tt.section, tt.field); got != tt.want {
+				t.Errorf("setTOMLLicense() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// แพ็กเกจ Cargo ที่สงวนสิทธิ์ชี้ license-file ไปที่ LICENSE ที่ root แม้ Cargo.toml จะอยู่ในโฟลเดอร์ย่อย
+func TestApplyLicenseCargoProprietary(t *testing.T) {
+	root := t.TempDir()
+	w := newWriter(root)
+	cargo := "[package]\nname = \"app\"\nversion = \"0.1.0\"\nlicense = \"MIT\"\n"
+	for _, rel := range []string{"Cargo.toml", "src-tauri/Cargo.toml"} {
+		if err := w.writeFile(rel, []byte(cargo)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := applyLicense(w, ui.ProjectOptions{Name: "app", License: "all-rights-reserved", LicenseYear: 2026}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"Cargo.toml": `license-file = "LICENSE"`, "src-tauri/Cargo.toml": `license-file = "../LICENSE"`}
+	for rel, field := range want {
+		b, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(b), field+"\n") || strings.Contains(string(b), "license = ") {
+			t.Errorf("%s =\n%s\nต้องมี %s แทน license", rel, b, field)
+		}
+	}
+	b, err := os.ReadFile(filepath.Join(root, "LICENSE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "2026 the app authors. All rights reserved.") {
+		t.Errorf("LICENSE =\n%s", b)
+	}
+}
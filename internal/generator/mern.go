@@ -0,0 +1,63 @@
+package generator
+
+// MERN stack: npm workspaces client (React + Vite) และ server (Express + Mongoose) ใน repository เดียว
+// production รัน server ตัวเดียวที่เสิร์ฟ client/dist ด้วย (NODE_ENV=production) และต้องมี MongoDB
+
+import (
+	"fmt"
+
+	"projgen/internal/ui"
+)
+
+// mernStackName ชื่อ framework ของเทมเพลต MERN ในแค็ตตาล็อก
+const mernStackName = "mern-stack"
+
+func isMERN(opts ui.ProjectOptions) bool {
+	return opts.Framework.Name == mernStackName
+}
+
+// mernDockerfile ติดตั้ง dependencies ของทุก workspace (ต้องมี package.json ของ client/server ก่อน npm ci)
+// build client แล้วรัน server ในโหมด production
+func mernDockerfile(opts ui.ProjectOptions) string {
+	return fmt.Sprintf(`FROM node:20-alpine
+WORKDIR /app
+COPY package*.json ./
+COPY client/package.json client/
+COPY server/package.json server/
+RUN npm ci || npm install
+COPY . .
+RUN npm run build
+ENV NODE_ENV=production
+EXPOSE %d
+CMD ["npm","run","start"]
+`, defaultPort(opts))
+}
+
+// mernCompose docker-compose.yml ของแอปพร้อม MongoDB (MONGODB_URI ชี้ไปที่ service mongo)
+func mernCompose(opts ui.ProjectOptions) string {
+	name, port := toKebab(opts.Name), defaultPort(opts)
+	envFile := ""
+	if contains(opts.Extras, "env") {
+		envFile = "    env_file:\n      - .env\n"
+	}
+	return fmt.Sprintf(`services:
+  %s:
+    build: .
+    ports:
+      - "%d:%d"
+%s    environment:
+      - PORT=%d
+      - MONGODB_URI=mongodb://mongo:27017/%s
+    depends_on:
+      - mongo
+    restart: unless-stopped
+  mongo:
+    image: mongo:7
+    volumes:
+      - mongo-data:/data/db
+    restart: unless-stopped
+
+volumes:
+  mongo-data:
+`, name, port, port, envFile, port, name)
+}
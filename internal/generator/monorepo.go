@@ -0,0 +1,388 @@
+package generator
+
+// monorepo: รวมเทมเพลต frontend (apps/web) และ backend (apps/api) ที่มีอยู่แล้วไว้ใน repository เดียว
+// ไฟล์คงที่ระดับ root อยู่ในเทมเพลต fullstack/monorepo ส่วน package.json, workspace, go.work และ docker-compose
+// สร้างจากแอปที่เลือก เพราะคำสั่งและพอร์ตขึ้นกับ framework ของแต่ละแอป
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"projgen/internal/config"
+	"projgen/internal/ui"
+)
+
+const (
+	webDir = "apps/web"
+	apiDir = "apps/api"
+
+	// goWorkVersion ให้ตรงกับ go directive ใน go.mod ของเทมเพลต Go
+	goWorkVersion = "1.25.3"
+)
+
+// packageManagerSpecs ค่า packageManager ใน package.json ระดับ root (Turborepo ต้องมี)
+var packageManagerSpecs = map[string]string{
+	"npm":  "npm@10.9.2",
+	"pnpm": "pnpm@9.15.0",
+	"yarn": "yarn@1.22.22",
+	"bun":  "bun@1.2.0",
+}
+
+// isMonorepo ตรวจว่าโปรเจ็กต์เป็น monorepo ที่เลือก frontend และ backend ไว้ครบ
+func isMonorepo(opts ui.ProjectOptions) bool {
+	return opts.Framework.Name == config.MonorepoName && opts.Frontend != nil && opts.Backend != nil
+}
+
+// monorepoPackageManager package manager ของทั้ง workspace: ตามเครื่องมือที่เลือก หรือค่าใน config สำหรับ Turborepo/Nx
+func monorepoPackageManager(opts ui.ProjectOptions) string {
+	switch opts.Variant {
+	case "npm", "pnpm":
+		return opts.Variant
+	}
+	if pm := strings.ToLower(opts.PackageManager); pm != "" {
+		return pm
+	}
+	return "npm"
+}
+
+// monorepoApps คืน options ของแอป frontend และ backend ที่ปรับคำสั่งตาม variant และ package manager แล้ว
+// พอร์ตของ backend จะถูกเลื่อนถ้าชนกับพอร์ตของ frontend (เช่น Next.js กับ Express ที่ใช้ 3000 ทั้งคู่)
+func monorepoApps(opts ui.ProjectOptions) (web, api ui.ProjectOptions) {
+	app := func(fw config.FrameworkOption, pt config.ProjectType, suffix string) ui.ProjectOptions {
+		a := ui.ProjectOptions{
+			Name:           toKebab(opts.Name) + "-" + suffix,
+			ProjectType:    pt,
+			Framework:      fw,
+			Runtime:        fw.Runtime,
+			PackageManager: opts.PackageManager,
+			AuthorName:     opts.AuthorName,
+			AuthorEmail:    opts.AuthorEmail,
+			License:        opts.License,
+		}
+		if strings.EqualFold(fw.Language, "Go") {
+			a.GoModule = goModule(opts) + "/" + apiDir
+		}
+		return applyPackageManager(applyVariant(a))
+	}
+	web = app(*opts.Frontend, config.Frontend, "web")
+	api = app(*opts.Backend, config.Backend, "api")
+	if defaultPort(api) == defaultPort(web) {
+		api.Framework.Port = defaultPort(web) + 1
+		api = applyPort(api)
+	}
+	return web, api
+}
+
+// renderMonorepo เรนเดอร์เทมเพลตของแต่ละแอปลง apps/web และ apps/api แล้วสร้างไฟล์ระดับ root ที่ผูกสองแอปเข้าด้วยกัน
+func renderMonorepo(w *writer, opts ui.ProjectOptions, sources []string) error {
+	web, api := monorepoApps(opts)
+	apps := []struct {
+		dir  string
+		opts ui.ProjectOptions
+	}{{webDir, web}, {apiDir, api}}
+
+	for _, app := range apps {
+		tmplDir := resolveTemplateDir(app.opts, sources)
+		if tmplDir == "" {
+			return missingTemplateError(app.opts.Framework)
+		}
+		err := w.inDir(app.dir, func() error {
+			if err := copyRenderTemplateDir(w, tmplDir, app.opts); err != nil {
+				return err
+			}
+			// docker-compose ระดับ root build แต่ละแอปจาก Dockerfile ในโฟลเดอร์ของแอป
+			if !w.wrote("Dockerfile") {
+				if err := w.writeFile("Dockerfile", []byte(appDockerfile(app.opts, app.dir))); err != nil {
+					return err
+				}
+			}
+			return extendBaseTSConfig(w, app.dir)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", app.dir, err)
+		}
+	}
+	if err := w.inDir(webDir, func() error { return addDevProxy(w, web, api) }); err != nil {
+		return err
+	}
+	if wrapsBackend(api) {
+		if err := w.inDir(apiDir, func() error { return w.writeFile("package.json", backendPackageJSON(api)) }); err != nil {
+			return err
+		}
+	}
+
+	files, err := monorepoRootFiles(opts, web, api)
+	if err != nil {
+		return err
+	}
+	rels := make([]string, 0, len(files))
+	for rel := range files {
+		rels = append(rels, rel)
+	}
+	sort.Strings(rels)
+	for _, rel := range rels {
+		if err := w.writeFile(rel, files[rel]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// monorepoRootFiles ไฟล์ระดับ root ที่ขึ้นกับแอปที่เลือก: package.json, pnpm-workspace.yaml, go.work และ docker-compose.yml
+func monorepoRootFiles(opts ui.ProjectOptions, web, api ui.ProjectOptions) (map[string][]byte, error) {
+	pm := monorepoPackageManager(opts)
+	pkg, err := rootPackageJSON(opts, pm, web, api)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{
+		"package.json":       pkg,
+		"docker-compose.yml": []byte(monorepoCompose(web, api)),
+	}
+	if pm == "pnpm" {
+		files["pnpm-workspace.yaml"] = []byte("packages:\n  - " + webDir + "\n  - " + apiDir + "\n")
+	}
+	if strings.EqualFold(api.Framework.Language, "Go") {
+		files["go.work"] = []byte(fmt.Sprintf("go %s\n\nuse ./%s\n", goWorkVersion, apiDir))
+	}
+	return files, nil
+}
+
+type packageScripts struct {
+	Dev   string `json:"dev,omitempty"`
+	Build string `json:"build,omitempty"`
+	Test  string `json:"test,omitempty"`
+}
+
+type packageJSON struct {
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	Private         bool              `json:"private"`
+	PackageManager  string            `json:"packageManager,omitempty"`
+	Workspaces      []string          `json:"workspaces,omitempty"`
+	Scripts         packageScripts    `json:"scripts"`
+	DevDependencies map[string]string `json:"devDependencies,omitempty"`
+}
+
+// rootPackageJSON package.json ระดับ root: dev รันทั้งสองแอปพร้อมกันด้วย concurrently ส่วน build/test ใช้เครื่องมือของ workspace
+func rootPackageJSON(opts ui.ProjectOptions, pm string, web, api ui.ProjectOptions) ([]byte, error) {
+	pkg := packageJSON{
+		Name:           toKebab(opts.Name),
+		Version:        "0.1.0",
+		Private:        true,
+		PackageManager: packageManagerSpecs[pm],
+		Scripts: packageScripts{
+			Dev: fmt.Sprintf(`concurrently -n web,api -c cyan,magenta "%s" "%s"`,
+				inAppDir(webDir, web.Framework.StartCmd), inAppDir(apiDir, api.Framework.StartCmd)),
+		},
+		DevDependencies: map[string]string{"concurrently": "^9.2.0"},
+	}
+	if pm != "pnpm" {
+		pkg.Workspaces = []string{webDir, apiDir}
+	}
+	switch {
+	case opts.Variant == "turborepo":
+		pkg.Scripts.Build, pkg.Scripts.Test = "turbo run build", "turbo run test"
+		pkg.DevDependencies["turbo"] = "^2.5.0"
+	case opts.Variant == "nx":
+		pkg.Scripts.Build, pkg.Scripts.Test = "nx run-many -t build", "nx run-many -t test"
+		pkg.DevDependencies["nx"] = "^21.0.0"
+	case pm == "pnpm":
+		pkg.Scripts.Build, pkg.Scripts.Test = "pnpm -r run build", "pnpm -r run test"
+	default:
+		pkg.Scripts.Build, pkg.Scripts.Test = "npm run build --workspaces --if-present", "npm run test --workspaces --if-present"
+	}
+	return marshalJSON(pkg)
+}
+
+// wrapsBackend ตรวจว่า backend ต้องมี package.json ห่อคำสั่งไว้หรือไม่ (แอปที่ไม่ใช่ Node/Bun ไม่มี package.json ของตัวเอง)
+// เพื่อให้ npm/pnpm workspaces, Turborepo และ Nx มองเห็นและรัน build/test ของแอปนั้นได้
+func wrapsBackend(api ui.ProjectOptions) bool {
+	return api.Framework.Runtime != "node" && api.Framework.Runtime != "bun"
+}
+
+// backendPackageJSON package.json ที่ห่อคำสั่ง dev/build/test ของ backend ภาษาอื่น
+func backendPackageJSON(api ui.ProjectOptions) []byte {
+	b, _ := marshalJSON(packageJSON{
+		Name:    api.Name,
+		Version: "0.1.0",
+		Private: true,
+		Scripts: packageScripts{
+			Dev:   api.Framework.StartCmd,
+			Build: api.Framework.BuildCmd,
+			Test:  testCommand(api),
+		},
+	})
+	return b
+}
+
+// testCommand คำสั่งรันเทสต์ของแอปตามรันไทม์
+func testCommand(opts ui.ProjectOptions) string {
+	switch opts.Framework.Runtime {
+	case "go":
+		return "go test ./..."
+	case "rust":
+		return "cargo test"
+	case "deno":
+		return "deno task test"
+	case "java":
+		if opts.Variant == "maven" {
+			return "./mvnw test"
+		}
+		return "./gradlew test"
+	case "python":
+		if strings.HasPrefix(opts.Framework.InstallCmd, "uv ") {
+			return uvRun("pytest")
+		}
+		return inVenv("pytest")
+	}
+	return ""
+}
+
+// inAppDir ครอบคำสั่งให้รันในโฟลเดอร์ของแอปสำหรับใช้ใน concurrently (อยู่ในเครื่องหมายคำพูดคู่)
+func inAppDir(dir, cmdStr string) string {
+	return "cd " + dir + " && " + strings.ReplaceAll(cmdStr, `"`, `\"`)
+}
+
+// buildsFromRoot ตรวจว่าแอปต้อง build โดยใช้ root ของ repo เป็น build context
+// แอป Node/Bun/Deno ต้องเห็น tsconfig.base.json ที่ tsconfig ของแอป extends (อยู่นอกโฟลเดอร์ของแอป)
+func buildsFromRoot(app ui.ProjectOptions) bool {
+	switch app.Framework.Runtime {
+	case "node", "bun", "deno":
+		return true
+	}
+	return false
+}
+
+// bakesAPIURL ตรวจว่า frontend อ่าน API_URL ตอน build (rewrites ของ Next.js ถูกฝังไว้ในผลลัพธ์ของ next build)
+// จึงต้องส่งเป็น build arg แทน environment ตอนรัน
+func bakesAPIURL(web ui.ProjectOptions) bool {
+	return web.Framework.Name == "nextjs-ts"
+}
+
+// appDockerfile Dockerfile ของแอปใน monorepo ให้ตรงกับ build context ใน monorepoCompose
+func appDockerfile(app ui.ProjectOptions, dir string) string {
+	if !buildsFromRoot(app) {
+		return dockerfileFor(app)
+	}
+	if dir == webDir && bakesAPIURL(app) {
+		return jsDockerfile(app, dir, "API_URL")
+	}
+	return jsDockerfile(app, dir)
+}
+
+// composeBuild ส่วน build ของ service ใน docker-compose.yml (args อยู่ในรูป NAME=value)
+func composeBuild(app ui.ProjectOptions, dir string, args ...string) string {
+	if !buildsFromRoot(app) {
+		return "build: ./" + dir + "\n"
+	}
+	var sb strings.Builder
+	sb.WriteString("build:\n      context: .\n      dockerfile: " + dir + "/Dockerfile\n")
+	if len(args) > 0 {
+		sb.WriteString("      args:\n")
+		for _, arg := range args {
+			sb.WriteString("        - " + arg + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// monorepoCompose docker-compose.yml ที่ build ทั้งสองแอปและชี้ frontend ไปที่ backend ผ่าน API_URL
+func monorepoCompose(web, api ui.ProjectOptions) string {
+	webPort, apiPort := defaultPort(web), defaultPort(api)
+	apiURL := fmt.Sprintf("API_URL=http://api:%d", apiPort)
+	var webArgs []string
+	if bakesAPIURL(web) {
+		webArgs = append(webArgs, apiURL)
+	}
+	return fmt.Sprintf(`services:
+  web:
+    %s    ports:
+      - "%d:%d"
+    environment:
+      - %s
+    depends_on:
+      - api
+    restart: unless-stopped
+  api:
+    %s    ports:
+      - "%d:%d"
+    environment:
+      - PORT=%d
+    restart: unless-stopped
+`, composeBuild(web, webDir, webArgs...), webPort, webPort, apiURL, composeBuild(api, apiDir), apiPort, apiPort, apiPort)
+}
+
+// addDevProxy ให้ dev server ของ frontend ส่งต่อ /api ไปที่ backend (ตัด /api ออกก่อนส่ง)
+// API_URL ใช้แทนที่อยู่ของ backend ได้ เช่น ใน docker-compose (Next.js อ่านค่าตอน build จึงรับผ่าน build arg)
+func addDevProxy(w *writer, web, api ui.ProjectOptions) error {
+	target := fmt.Sprintf("http://localhost:%d", defaultPort(api))
+	switch {
+	case strings.HasPrefix(web.Framework.Name, "vite-"):
+		const anchor = "// https://vite.dev/config/\nexport default defineConfig({\n"
+		return patchFile(w, "vite.config.ts", anchor, ""+
+			"// Forward /api to the backend in apps/api, for both the dev server and vite preview.\n"+
+			"const apiProxy = {\n"+
+			"  '/api': {\n"+
+			"    target: process.env.API_URL ?? '"+target+"',\n"+
+			"    changeOrigin: true,\n"+
+			"    rewrite: (path: string) => path.replace(/^\\/api/, ''),\n"+
+			"  },\n"+
+			"}\n\n"+
+			anchor+
+			"  server: { proxy: apiProxy },\n"+
+			"  preview: { proxy: apiProxy },\n")
+	case web.Framework.Name == "nextjs-ts":
+		return patchFile(w, "next.config.ts", "const nextConfig: NextConfig = {\n", "const nextConfig: NextConfig = {\n"+
+			"  // Forward /api to the backend in apps/api; routes under app/api take precedence.\n"+
+			"  async rewrites() {\n"+
+			"    return [\n"+
+			"      {\n"+
+			"        source: \"/api/:path*\",\n"+
+			"        destination: `${process.env.API_URL || \""+target+"\"}/:path*`,\n"+
+			"      },\n"+
+			"    ];\n"+
+			"  },\n")
+	}
+	return nil
+}
+
+// extendBaseTSConfig ให้ tsconfig ของแอป extends tsconfig.base.json ที่ root (ข้ามถ้าแอปไม่มี tsconfig หรือ extends อยู่แล้ว)
+func extendBaseTSConfig(w *writer, dir string) error {
+	base := strings.Repeat("../", strings.Count(dir, "/")+1) + "tsconfig.base.json"
+	for _, rel := range []string{"tsconfig.app.json", "tsconfig.json"} {
+		b, ok := w.read(rel)
+		if !ok {
+			continue
+		}
+		if bytes.Contains(b, []byte(`"extends"`)) {
+			return nil
+		}
+		return patchFile(w, rel, "{\n", "{\n  \"extends\": \""+base+"\",\n")
+	}
+	return nil
+}
+
+// patchFile แทนที่ old ครั้งแรกด้วย new ในไฟล์ที่ writer เขียนไว้ในรอบนี้ (ไม่แตะไฟล์เดิมของผู้ใช้)
+func patchFile(w *writer, rel, old, new string) error {
+	b, ok := w.read(rel)
+	if !ok || !bytes.Contains(b, []byte(old)) {
+		return nil
+	}
+	return w.writeFile(rel, bytes.Replace(b, []byte(old), []byte(new), 1))
+}
+
+// marshalJSON เหมือน json.MarshalIndent แต่ไม่ escape &, < และ > (คำสั่งใน scripts มี &&)
+func marshalJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
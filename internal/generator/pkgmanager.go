@@ -0,0 +1,94 @@
+package generator
+
+// แปลงคำสั่ง npm/npx ในแค็ตตาล็อกให้เป็นคำสั่งของ package manager ที่ผู้ใช้ตั้งค่าไว้ (pnpm, yarn, bun)
+
+import (
+	"strings"
+
+	"projgen/internal/ui"
+)
+
+// applyPackageManager ปรับคำสั่งติดตั้ง/รัน/build ของ framework และ addons ตาม opts.PackageManager
+// มีผลเฉพาะโปรเจ็กต์ที่ใช้รันไทม์ node เท่านั้น
+func applyPackageManager(opts ui.ProjectOptions) ui.ProjectOptions {
+	pm := strings.ToLower(opts.PackageManager)
+	if pm == "" || pm == "npm" || opts.Framework.Runtime != "node" {
+		return opts
+	}
+	opts.Framework.InstallCmd = adaptCommand(pm, opts.Framework.InstallCmd)
+	opts.Framework.StartCmd = adaptCommand(pm, opts.Framework.StartCmd)
+	opts.Framework.BuildCmd = adaptCommand(pm, opts.Framework.BuildCmd)
+	if opts.CSSFramework != nil {
+		css := *opts.CSSFramework
+		css.InstallCmd = adaptCommand(pm, css.InstallCmd)
+		opts.CSSFramework = &css
+	}
+	if opts.UILibrary != nil {
+		lib := *opts.UILibrary
+		lib.InstallCmd = adaptCommand(pm, lib.InstallCmd)
+		opts.UILibrary = &lib
+	}
+	return opts
+}
+
+// adaptCommand แปลงคำสั่งทีละส่วน (รองรับคำสั่งที่เชื่อมด้วย &&)
+func adaptCommand(pm, cmdStr string) string {
+	if cmdStr == "" {
+		return cmdStr
+	}
+	parts := strings.Split(cmdStr, "&&")
+	for i, part := range parts {
+		parts[i] = adaptSingle(pm, strings.TrimSpace(part))
+	}
+	return strings.Join(parts, " && ")
+}
+
+func adaptSingle(pm, cmdStr string) string {
+	fields := strings.Fields(cmdStr)
+	if len(fields) == 0 {
+		return cmdStr
+	}
+	if fields[0] == "npx" {
+		runner := map[string]string{"pnpm": "pnpm dlx", "yarn": "yarn dlx", "bun": "bunx"}[pm]
+		if runner == "" {
+			return cmdStr
+		}
+		return strings.Join(append([]string{runner}, fields[1:]...), " ")
+	}
+	if fields[0] != "npm" {
+		return cmdStr
+	}
+
+	if len(fields) == 1 {
+		return pm
+	}
+	args := fields[2:]
+	switch fields[1] {
+	case "install", "i", "ci":
+		if !hasPackageArgs(args) {
+			return strings.Join(append([]string{pm, "install"}, args...), " ")
+		}
+		// npm install <pkgs> -> <pm> add <pkgs>
+		return strings.Join(append([]string{pm, "add"}, args...), " ")
+	case "uninstall", "remove", "rm":
+		return strings.Join(append([]string{pm, "remove"}, args...), " ")
+	case "run", "run-script":
+		return strings.Join(append([]string{pm, "run"}, args...), " ")
+	case "start", "stop", "restart", "test":
+		// ใช้ <pm> run เสมอ: bun test เป็น test runner ของ Bun เองไม่ใช่สคริปต์ test ใน package.json
+		return strings.Join(append([]string{pm, "run", fields[1]}, args...), " ")
+	default:
+		// คำสั่งอื่นของ npm (เช่น npm pkg) ไม่มีคำสั่งที่เทียบเท่าใน package manager อื่น
+		return cmdStr
+	}
+}
+
+// hasPackageArgs ตรวจว่ามีชื่อแพ็กเกจ (argument ที่ไม่ใช่ flag) หรือไม่
+func hasPackageArgs(args []string) bool {
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") {
+			return true
+		}
+	}
+	return false
+}
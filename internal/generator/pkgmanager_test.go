@@ -0,0 +1,35 @@
+package generator
+
+import "testing"
+
+func TestAdaptCommand(t *testing.T) {
+	tests := []struct {
+		pm, in, want string
+	}{
+		{"pnpm", "", ""},
+		{"pnpm", "npm install", "pnpm install"},
+		{"yarn", "npm ci", "yarn install"},
+		{"pnpm", "npm install -D eslint", "pnpm add -D eslint"},
+		{"bun", "npm i zod", "bun add zod"},
+		{"yarn", "npm uninstall eslint", "yarn remove eslint"},
+		{"pnpm", "npm run dev", "pnpm run dev"},
+		{"bun", "npm test --if-present", "bun run test --if-present"},
+		{"pnpm", "npm start", "pnpm run start"},
+		{"bun", "npm run-script lint", "bun run lint"},
+		{"pnpm", "npm install --legacy-peer-deps", "pnpm install --legacy-peer-deps"},
+		{"yarn", "npm install -D", "yarn install -D"},
+		{"pnpm", "npm pkg set type=module", "npm pkg set type=module"},
+		{"pnpm", "npm", "pnpm"},
+		{"pnpm", "npx prisma generate", "pnpm dlx prisma generate"},
+		{"yarn", "npx tsc", "yarn dlx tsc"},
+		{"bun", "npx tsc", "bunx tsc"},
+		{"pnpm", "go mod tidy", "go mod tidy"},
+		{"pnpm", "npm install && npx prisma generate", "pnpm install && pnpm dlx prisma generate"},
+		{"pnpm", "npm install&&npm run build", "pnpm install && pnpm run build"},
+	}
+	for _, tt := range tests {
+		if got := adaptCommand(tt.pm, tt.in); got != tt.want {
+			t.Errorf("adaptCommand(%q, %q) = %q, want %q", tt.pm, tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package generator
+
+// ปรับคำสั่งติดตั้ง/รันของโปรเจ็กต์ Python ตามเครื่องมือบนเครื่อง: uv ถ้ามี ไม่งั้นใช้ virtualenv (.venv) + pip
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"projgen/internal/ui"
+)
+
+// preparePython เลือกเครื่องมือของโปรเจ็กต์ Python: uv ถ้ามี ไม่งั้น python ที่ผ่านเวอร์ชันขั้นต่ำ + venv
+func preparePython(ctx context.Context, opts ui.ProjectOptions) ui.ProjectOptions {
+	// uv จัดการเวอร์ชัน Python ตาม requires-python ใน pyproject.toml เอง
+	if _, err := exec.LookPath("uv"); err == nil {
+		return applyPython(opts, "", true)
+	}
+	bin := requireRuntime(ctx, "python", opts.Framework.MinVersion)
+	return applyPython(opts, bin, false)
+}
+
+// applyPython แปลงคำสั่งในแค็ตตาล็อก (รูปแบบ venv + pip) ให้ใช้ python ที่พบ หรือ uv
+// คำสั่ง start/build จะเรียกผ่าน .venv โดยตรงจึงไม่ต้อง activate ก่อน
+func applyPython(opts ui.ProjectOptions, python string, uv bool) ui.ProjectOptions {
+	fw := &opts.Framework
+	if uv {
+		fw.InstallCmd = "uv sync --extra dev"
+		fw.StartCmd = uvRun(fw.StartCmd)
+		fw.BuildCmd = uvRun(fw.BuildCmd)
+		return opts
+	}
+	if python == "" {
+		python = "python3"
+		if runtime.GOOS == "windows" {
+			python = "python"
+		}
+	}
+	fw.InstallCmd = python + " -m venv .venv && " + venvBin("python") + ` -m pip install -e ".[dev]"`
+	fw.StartCmd = inVenv(fw.StartCmd)
+	fw.BuildCmd = inVenv(fw.BuildCmd)
+	return opts
+}
+
+func uvRun(cmdStr string) string {
+	if cmdStr == "" {
+		return ""
+	}
+	return "uv run " + cmdStr
+}
+
+// inVenv เรียกโปรแกรมแรกของคำสั่งจาก .venv เช่น uvicorn ... -> .venv/bin/uvicorn ...
+func inVenv(cmdStr string) string {
+	fields := strings.Fields(cmdStr)
+	if len(fields) == 0 {
+		return cmdStr
+	}
+	fields[0] = venvBin(fields[0])
+	return strings.Join(fields, " ")
+}
+
+func venvBin(name string) string {
+	if runtime.GOOS == "windows" {
+		return `.venv\Scripts\` + name
+	}
+	return ".venv/bin/" + name
+}
@@ -0,0 +1,370 @@
+package generator
+
+// ถอด addon/extra ออกจากโปรเจ็กต์ที่มีอยู่แล้ว (projgen remove) — ลบเฉพาะสิ่งที่พิสูจน์ได้ว่า projgen สร้างและยังไม่ถูกแก้ไข
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/gitignore"
+	"projgen/internal/manifest"
+	"projgen/internal/ui"
+)
+
+// RemoveOptions ตัวเลือกของ projgen remove
+type RemoveOptions struct {
+	Force   bool // ลบไฟล์แม้ถูกแก้ไขแล้ว
+	Install bool // รันคำสั่ง uninstall ของ package manager
+}
+
+// RemoveResult สรุปผลการถอด addon
+type RemoveResult struct {
+	Removed []string          // ไฟล์ที่ลบแล้ว
+	Updated []string          // ไฟล์ที่ตัดเฉพาะส่วนของ addon ออก (เช่น .gitignore)
+	Kept    map[string]string // ไฟล์ที่ไม่ได้ลบ -> เหตุผล
+	Manual  []string          // สิ่งที่ต้องทำเอง
+}
+
+// Remove ถอด addon หรือ extra ชื่อ name ออกจากโปรเจ็กต์ใน dir
+func Remove(ctx context.Context, dir, name string, cfg *config.Config, ropts RemoveOptions) (*RemoveResult, error) {
+	opts, m, err := loadProject(dir, cfg)
+	if err != nil {
+		return nil, err
+	}
+	res := &RemoveResult{Kept: map[string]string{}}
+
+	// expected คือเนื้อหาที่ projgen จะสร้าง ใช้ตรวจไฟล์เมื่อ manifest ไม่ได้บันทึก hash ไว้
+	// exact: ต้องตรงกับ expected ทุกไบต์ (manifest รุ่นเก่าไม่ได้บันทึกว่าไฟล์ใดเป็นของ extra
+	// hash ใน manifest อาจเป็นของไฟล์ที่เทมเพลตสร้างไว้ในชื่อเดียวกัน)
+	var files []string
+	expected := map[string]string{}
+	exact := false
+	var installCmd, display, extra string
+	if ex, ok := config.FindExtra(name); ok {
+		display = ex.DisplayName
+		extra = ex.Name
+		if m != nil && !contains(opts.Extras, ex.Name) {
+			return nil, fmt.Errorf("%s ไม่ได้ถูกเพิ่มไว้ในโปรเจ็กต์นี้", ex.DisplayName)
+		}
+		// .gitignore รวมอยู่กับ pattern ของเทมเพลตและของผู้ใช้ จึงตัดเฉพาะบรรทัดที่ projgen เพิ่ม (removeGitignore)
+		owned, recorded := recordedExtraFiles(m, ex.Name)
+		for rel, content := range extraFiles(ex.Name, opts) {
+			if ex.Name == "gitignore" || (recorded && !contains(owned, rel)) {
+				continue
+			}
+			files = append(files, rel)
+			expected[rel] = content
+		}
+		exact = !recorded
+		// ใช้คำสั่งต้นฉบับ (npm) เพื่อแยกชื่อแพ็กเกจ แล้วค่อยแปลงเป็นคำสั่งของ package manager
+		if extraCommand(ex, opts) != "" {
+			installCmd = ex.Value
+		}
+		opts.Extras = without(opts.Extras, ex.Name)
+	} else if css, ok := config.FindCSSFramework(name); ok {
+		display = css.DisplayName
+		if m != nil && (opts.CSSFramework == nil || opts.CSSFramework.Name != css.Name) {
+			return nil, fmt.Errorf("%s ไม่ได้ถูกเพิ่มไว้ในโปรเจ็กต์นี้", css.DisplayName)
+		}
+		files = append(files, css.ConfigFiles...)
+		installCmd = css.InstallCmd
+		opts.CSSFramework = nil
+	} else if lib, ok := config.FindUILibrary(name); ok {
+		display = lib.DisplayName
+		if m != nil && (opts.UILibrary == nil || opts.UILibrary.Name != lib.Name) {
+			return nil, fmt.Errorf("%s ไม่ได้ถูกเพิ่มไว้ในโปรเจ็กต์นี้", lib.DisplayName)
+		}
+		installCmd = lib.InstallCmd
+		opts.UILibrary = nil
+	} else {
+		return nil, fmt.Errorf("ไม่รู้จัก addon %q (รองรับ: %s)", name, strings.Join(AddonNames(), ", "))
+	}
+	sort.Strings(files)
+
+	// ไฟล์ที่เทมเพลตสร้าง (เมื่อไม่มี addon นี้แล้ว) ไม่ใช่ของ addon ไม่ลบแม้ใช้ --force
+	owners, err := templateFiles(m, opts, cfg.TemplateSources)
+	if err != nil {
+		return nil, err
+	}
+	if extra == "gitignore" {
+		if err := removeGitignore(dir, m, opts, owners[".gitignore"], res); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rel := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+		h, err := manifest.HashFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		reason := ""
+		if _, ok := owners[rel]; ok {
+			res.Kept[rel] = reasonTemplateFile
+			continue
+		}
+		if content, ok := expected[rel]; ok && exact {
+			if h != manifest.HashBytes([]byte(content)) {
+				reason = "ไม่ตรงกับไฟล์ที่ projgen สร้าง"
+			}
+		} else if recorded := manifestHash(m, rel); recorded != "" {
+			if h != recorded {
+				reason = "ถูกแก้ไขหลังจากสร้าง"
+			}
+		} else if content, ok := expected[rel]; ok {
+			if h != manifest.HashBytes([]byte(content)) {
+				reason = "ถูกแก้ไขหลังจากสร้าง"
+			}
+		} else {
+			reason = "ไม่มีบันทึกว่าสร้างโดย projgen"
+		}
+		if reason != "" && !ropts.Force {
+			res.Kept[rel] = reason
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+		removeEmptyParents(dir, filepath.Dir(path))
+		res.Removed = append(res.Removed, rel)
+		if m != nil {
+			delete(m.Files, rel)
+			delete(m.Installed, rel)
+		}
+	}
+
+	// ถอนแพ็กเกจที่คำสั่งติดตั้งเพิ่มไว้ใน package.json
+	// ขั้นตอนที่ไม่ใช่การติดตั้งแพ็กเกจ (เช่น init) ย้อนได้ด้วยการลบไฟล์ข้างบนเมื่อรู้จักไฟล์ที่สร้าง
+	pkgs, skipped := installedPackages(installCmd)
+	if len(files) > 0 {
+		skipped = nil
+	}
+	for _, s := range skipped {
+		res.Manual = append(res.Manual, fmt.Sprintf("ย้อนผลของคำสั่ง %s ด้วยตัวเอง", pterm.Cyan(s)))
+	}
+	if len(pkgs) > 0 {
+		pm := strings.ToLower(opts.PackageManager)
+		if pm == "" {
+			pm = "npm"
+		}
+		uninstall := adaptCommand(pm, "npm uninstall "+strings.Join(pkgs, " "))
+		if !ropts.Install {
+			res.Manual = append(res.Manual, fmt.Sprintf("ถอนแพ็กเกจด้วยคำสั่ง: %s", pterm.Cyan(uninstall)))
+		} else {
+			var before map[string]string
+			if m != nil {
+				if before, err = trackedHashes(m, dir); err != nil {
+					return nil, err
+				}
+			}
+			spinner, _ := pterm.DefaultSpinner.Start(fmt.Sprintf("🔧 กำลังถอน %s...", display))
+			if err := runCommandInDir(ctx, dir, uninstall); err != nil {
+				spinner.Fail(fmt.Sprintf("ถอน %s ไม่สำเร็จ", display))
+				return res, err
+			}
+			spinner.Success(fmt.Sprintf("ถอน %s สำเร็จ", display))
+			if m != nil {
+				if err := recordInstalled(m, dir, before); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if m == nil {
+		return res, nil
+	}
+	// ยังถอดไม่หมด: คง addon ไว้ใน manifest เพื่อให้สั่ง remove --force ซ้ำได้
+	if len(res.Kept) == 0 {
+		m.Options = opts
+		delete(m.Extras, extra)
+	}
+	m.UpdatedAt = time.Now().UTC()
+	return res, manifest.Write(dir, m)
+}
+
+// installedPackages แยกชื่อแพ็กเกจจากขั้นตอน "npm install <pkgs>" ของคำสั่งติดตั้ง
+// ขั้นตอนอื่น (เช่น npx ... init) หรือชื่อแบบ wildcard คืนกลับมาใน skipped เพื่อให้ผู้ใช้จัดการเอง
+func installedPackages(cmdStr string) (pkgs, skipped []string) {
+	for _, step := range strings.Split(cmdStr, "&&") {
+		step = strings.TrimSpace(step)
+		if step == "" {
+			continue
+		}
+		fields := strings.Fields(step)
+		if len(fields) < 3 || fields[0] != "npm" || (fields[1] != "install" && fields[1] != "i") {
+			skipped = append(skipped, step)
+			continue
+		}
+		for _, f := range fields[2:] {
+			switch {
+			case strings.HasPrefix(f, "-"):
+			case strings.Contains(f, "*"):
+				skipped = append(skipped, "npm install "+f)
+			default:
+				pkgs = append(pkgs, f)
+			}
+		}
+	}
+	return pkgs, skipped
+}
+
+// removeEmptyParents ลบไดเรกทอรีว่างจาก path ขึ้นไปจนถึง root (ไม่รวม root)
+func removeEmptyParents(root, path string) {
+	root = filepath.Clean(root)
+	for path = filepath.Clean(path); path != root && strings.HasPrefix(path, root); path = filepath.Dir(path) {
+		if err := os.Remove(path); err != nil {
+			return
+		}
+	}
+}
+
+// reasonTemplateFile เหตุผลที่ไม่ลบไฟล์ซึ่งเทมเพลตของโปรเจ็กต์สร้างไว้ (--force ก็ไม่ลบ)
+const reasonTemplateFile = "เป็นไฟล์ของเทมเพลต"
+
+// recordedExtraFiles ไฟล์ที่ extra เขียนตามที่บันทึกใน manifest (recorded เป็น false สำหรับ manifest รุ่นเก่า)
+func recordedExtraFiles(m *manifest.Manifest, name string) (files []string, recorded bool) {
+	if m == nil || m.Extras == nil {
+		return nil, false
+	}
+	files, recorded = m.Extras[name]
+	return files, recorded
+}
+
+// templateFiles เรนเดอร์เทมเพลตที่บันทึกไว้ใน manifest ด้วย opts (ไม่รวม extra) แล้วคืนไฟล์ที่เทมเพลตสร้าง (path -> เนื้อหา)
+// โปรเจ็กต์ที่ไม่มี manifest หรือหาเทมเพลตไม่พบคืน map ว่าง
+func templateFiles(m *manifest.Manifest, opts ui.ProjectOptions, sources []string) (map[string][]byte, error) {
+	out := map[string][]byte{}
+	if m == nil {
+		return out, nil
+	}
+	tmplDir, err := resolveRecordedTemplate(m, sources)
+	if err != nil {
+		return out, nil
+	}
+	tmp, err := os.MkdirTemp("", "projgen-render-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	w := newWriter(tmp)
+	opts = applyVariant(opts)
+	if tmplDir != "" {
+		err = renderProject(w, tmplDir, opts, sources)
+	} else {
+		err = generateFallbackSkeleton(w, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range w.files {
+		b, err := os.ReadFile(filepath.Join(tmp, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, err
+		}
+		out[rel] = b
+	}
+	return out, nil
+}
+
+// removeGitignore ตัด fragment ที่ projgen รวมเข้าไปออกจาก .gitignore โดยคง pattern ของเทมเพลต (base) และของผู้ใช้ไว้
+// ลบไฟล์เมื่อไม่เหลือบรรทัดอื่น โปรเจ็กต์ที่สร้าง git repository ต้องมี .gitignore เสมอจึงไม่แตะไฟล์
+func removeGitignore(dir string, m *manifest.Manifest, opts ui.ProjectOptions, base []byte, res *RemoveResult) error {
+	const rel = ".gitignore"
+	if opts.GitInit {
+		res.Manual = append(res.Manual, "คง .gitignore ไว้เพราะโปรเจ็กต์สร้าง git repository (git.init)")
+		return nil
+	}
+	path := filepath.Join(dir, rel)
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// รวม fragment ของ Docker เสมอ เพราะตอนเพิ่มอาจมี Dockerfile อยู่แล้ว
+	content := gitignore.Strip(string(b), string(base), gitignoreFragments(opts, true)...)
+	switch {
+	case content == string(b):
+		return nil
+	case content == "":
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		res.Removed = append(res.Removed, rel)
+	default:
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return err
+		}
+		res.Updated = append(res.Updated, rel)
+	}
+	// hash ที่บันทึกไว้กลับไปเป็นของ .gitignore ที่เทมเพลตสร้าง ไฟล์ที่ผู้ใช้สร้างเองยังไม่ถูกบันทึกเหมือนเดิม
+	if m != nil && m.Files[rel] != "" {
+		if len(base) > 0 {
+			m.Files[rel] = manifest.HashBytes(base)
+		} else {
+			delete(m.Files, rel)
+		}
+	}
+	return nil
+}
+
+func manifestHash(m *manifest.Manifest, rel string) string {
+	if m == nil {
+		return ""
+	}
+	return m.Files[rel]
+}
+
+func without(list []string, item string) []string {
+	out := list[:0:0]
+	for _, v := range list {
+		if v != item {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// PrintRemoveResult แสดงผลการถอด addon
+func PrintRemoveResult(name string, res *RemoveResult) {
+	for _, rel := range res.Removed {
+		pterm.Success.Printfln("ลบ %s", rel)
+	}
+	for _, rel := range res.Updated {
+		pterm.Success.Printfln("แก้ไข %s", rel)
+	}
+	kept := make([]string, 0, len(res.Kept))
+	for rel := range res.Kept {
+		kept = append(kept, rel)
+	}
+	sort.Strings(kept)
+	for _, rel := range kept {
+		if res.Kept[rel] == reasonTemplateFile {
+			pterm.Warning.Printfln("ไม่ได้ลบ %s: %s", rel, res.Kept[rel])
+			continue
+		}
+		pterm.Warning.Printfln("ไม่ได้ลบ %s: %s (ใช้ --force เพื่อลบ)", rel, res.Kept[rel])
+	}
+	for _, s := range res.Manual {
+		pterm.Info.Printfln("   💡 %s", s)
+	}
+	if len(res.Removed) == 0 && len(res.Updated) == 0 && len(kept) == 0 && len(res.Manual) == 0 {
+		pterm.Info.Printfln("ไม่พบสิ่งที่ต้องถอดสำหรับ %s", name)
+	}
+}
@@ -0,0 +1,53 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"projgen/internal/manifest"
+	"projgen/internal/ui"
+)
+
+// remove ต้องลบเฉพาะไฟล์ที่ extra เขียน ไม่ลบไฟล์ชื่อเดียวกันที่เทมเพลตสร้าง แม้ใช้ --force
+func TestRemoveKeepsTemplateFiles(t *testing.T) {
+	tests := []struct {
+		framework   string
+		legacy      bool // manifest รุ่นเก่าที่ไม่มี extras
+		wantRemoved bool
+	}{
+		{"go-fiber", false, true}, // เทมเพลตไม่มี Dockerfile: ของ extra
+		{"go-grpc", false, false}, // เทมเพลตมี Dockerfile อยู่แล้ว
+		{"go-grpc", true, false},  // manifest ไม่ได้บันทึกว่า extra เขียนไฟล์ใด
+	}
+	for _, tt := range tests {
+		name := tt.framework
+		if tt.legacy {
+			name += " legacy"
+		}
+		t.Run(name, func(t *testing.T) {
+			dir, cfg := generateFixture(t, tt.framework, func(opts *ui.ProjectOptions) {
+				opts.Extras = []string{"dockerfile"}
+			})
+			if tt.legacy {
+				m, err := manifest.Read(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				m.Extras = nil
+				if err := manifest.Write(dir, m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if _, err := Remove(context.Background(), dir, "dockerfile", cfg, RemoveOptions{Force: true}); err != nil {
+				t.Fatalf("Remove: %v", err)
+			}
+			_, err := os.Stat(filepath.Join(dir, "Dockerfile"))
+			if removed := os.IsNotExist(err); removed != tt.wantRemoved {
+				t.Errorf("Dockerfile ถูกลบ = %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
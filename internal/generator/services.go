@@ -0,0 +1,277 @@
+package generator
+
+// สร้างหลาย service จากไฟล์ spec (projgen create --spec) ในครั้งเดียว
+// แต่ละ service สร้างด้วย Generate ตามปกติ (มี manifest ของตัวเอง) แล้วสร้าง docker-compose.yml และ README.md
+// ระดับ root ที่รวมทุก service พอร์ตของ service ที่ไม่ได้ระบุจะถูกเลื่อนจากค่าเริ่มต้นจนไม่ชนกัน
+// git repository (git.init) มีหนึ่งอันที่ root สำหรับ layout repo และหนึ่งอันต่อ service สำหรับ layout siblings
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/spec"
+	"projgen/internal/ui"
+)
+
+// servicesDir โฟลเดอร์ของ service ในรูปแบบ repository เดียว
+const servicesDir = "services"
+
+// plannedService service ที่กำหนดตัวเลือกและโฟลเดอร์ปลายทางแล้ว
+type plannedService struct {
+	opts      ui.ProjectOptions
+	dir       string // path ของโฟลเดอร์ service เทียบกับ root (คั่นด้วย /)
+	dependsOn []string
+}
+
+// GenerateServices สร้างทุก service ใน spec แล้วเขียน docker-compose.yml และ README.md ที่ root
+// root คือ gopts.Output หรือ ./<spec name> (layout repo) หรือโฟลเดอร์ปัจจุบัน (layout siblings)
+func GenerateServices(ctx context.Context, s *spec.Spec, cfg *config.Config, gopts GenerateOptions) error {
+	root, err := servicesRoot(s, gopts.Output)
+	if err != nil {
+		return err
+	}
+	planned := planServices(s, cfg)
+
+	// ตรวจโฟลเดอร์ทั้งหมดก่อนเริ่ม เพื่อไม่ให้สร้างไปได้ครึ่งหนึ่งแล้วค่อยล้มเหลว
+	if s.Layout == spec.LayoutRepo {
+		if err := ensureTargetDir(root, gopts.Merge); err != nil {
+			return err
+		}
+	} else if !gopts.Merge {
+		for _, rel := range []string{"docker-compose.yml", "README.md"} {
+			if _, err := os.Stat(filepath.Join(root, rel)); err == nil {
+				return fmt.Errorf("มีไฟล์ %s อยู่แล้วใน %s (ใช้ --merge หรือ --force เพื่อสร้างทับ)", rel, root)
+			}
+		}
+	}
+	for _, p := range planned {
+		if _, err := CheckOutputDir(filepath.Join(root, filepath.FromSlash(p.dir)), gopts.Merge); err != nil {
+			return err
+		}
+	}
+
+	// layout repo: git init ที่ root ก่อนสร้าง service (ให้สคริปต์ที่ติดตั้ง git hooks ระหว่าง install ทำงานได้)
+	// แล้ว commit ทุก service รวมกันหลังสร้างครบ
+	repoOpts := ui.ProjectOptions{Name: s.Name, AuthorName: cfg.AuthorName, AuthorEmail: cfg.AuthorEmail}
+	gitReady := false
+	if s.Layout == spec.LayoutRepo && cfg.GitInit {
+		if repoOpts.Name == "" {
+			repoOpts.Name = filepath.Base(root)
+		}
+		repoOpts.GitRemote = gitRemote(cfg, repoOpts.Name)
+		gitReady = initGitRepo(ctx, root, repoOpts, cfg)
+	}
+
+	sopts := gopts
+	sopts.Quiet = true
+	for i, p := range planned {
+		pterm.DefaultSection.Printfln("[%d/%d] %s (%s)", i+1, len(planned), p.opts.Name, p.opts.Framework.DisplayName)
+		sopts.Output = filepath.Join(root, filepath.FromSlash(p.dir))
+		if err := Generate(ctx, p.opts, cfg, sopts); err != nil {
+			return fmt.Errorf("service %s: %w", p.opts.Name, err)
+		}
+	}
+
+	w := newWriter(root)
+	if gopts.Merge {
+		w.policy, w.resolve = gopts.Conflict, gopts.Resolve
+		if w.policy == "" {
+			w.policy = PolicySkip
+		}
+	}
+	if compose := servicesCompose(planned); compose != "" {
+		if err := w.writeFile("docker-compose.yml", []byte(compose)); err != nil {
+			return err
+		}
+	}
+	if err := w.writeFile("README.md", []byte(servicesReadme(s, root, planned))); err != nil {
+		return err
+	}
+	if err := w.resolvePending(); err != nil {
+		return err
+	}
+	w.printConflicts()
+	if gitReady {
+		commitInitial(ctx, root, repoOpts, cfg)
+	}
+
+	printServicesSummary(root, planned)
+	return nil
+}
+
+// servicesRoot โฟลเดอร์ที่เก็บ docker-compose.yml และ README.md รวม
+func servicesRoot(s *spec.Spec, output string) (string, error) {
+	if strings.TrimSpace(output) != "" {
+		return validateOutputDir(output)
+	}
+	if s.Layout == spec.LayoutSiblings {
+		return os.Getwd()
+	}
+	if s.Name == "" {
+		return "", fmt.Errorf("ต้องระบุ name ใน spec หรือโฟลเดอร์ปลายทาง (--output) สำหรับ layout %s", spec.LayoutRepo)
+	}
+	return projectDirFromChoices(ui.ProjectOptions{Name: s.Name}, "")
+}
+
+// planServices แปลง service ใน spec เป็น ProjectOptions พร้อมพอร์ตที่ไม่ชนกัน
+// service ที่เป็น server จะได้ extra dockerfile เสมอเพราะ docker-compose ที่ root build จาก Dockerfile ของแต่ละ service
+// layout siblings: แต่ละ service เป็น git repository ของตัวเอง ส่วน layout repo ใช้ repository ที่ root
+// (service ได้ extra gitignore แทนเพื่อไม่ให้ node_modules หรือ .env ติดไปกับ commit)
+func planServices(s *spec.Spec, cfg *config.Config) []plannedService {
+	// remote ที่ระบุตรง ๆ ใช้ได้กับ repository เดียว service ที่แยก repository จึงใช้ git.remote-prefix เท่านั้น
+	if cfg.GitInit && cfg.GitRemote != "" && s.Layout == spec.LayoutSiblings && len(s.Services) > 1 {
+		pterm.Warning.Printfln("ข้าม git remote %s เพราะแต่ละ service เป็น repository แยกกัน (ใช้ git.remote-prefix แทน)", cfg.GitRemote)
+	}
+	planned := make([]plannedService, 0, len(s.Services))
+	for _, svc := range s.Services {
+		fw, pt, _ := config.FindFramework(svc.Framework)
+		opts := ui.ProjectOptions{
+			Name:           svc.Name,
+			ProjectType:    pt,
+			Framework:      fw,
+			Language:       fw.Language,
+			Runtime:        fw.Runtime,
+			Extras:         append([]string(nil), svc.Extras...),
+			AutoInstall:    s.Install,
+			PackageManager: cfg.PackageManager,
+			AuthorName:     cfg.AuthorName,
+			AuthorEmail:    cfg.AuthorEmail,
+			License:        cfg.License,
+			Variant:        svc.Variant,
+			GoModule:       svc.Module,
+		}
+		if opts.GoModule == "" && cfg.GoModulePrefix != "" && strings.EqualFold(fw.Language, "Go") {
+			opts.GoModule = cfg.GoModulePrefix + "/" + svc.Name
+		}
+		if svc.Port != 0 {
+			opts.Framework.Port = svc.Port
+		}
+		if defaultPort(opts) != 0 && !contains(opts.Extras, "dockerfile") {
+			opts.Extras = append(opts.Extras, "dockerfile")
+		}
+		dir := svc.Name
+		if s.Layout == spec.LayoutRepo {
+			dir = servicesDir + "/" + svc.Name
+			if cfg.GitInit && !contains(opts.Extras, "gitignore") {
+				opts.Extras = append(opts.Extras, "gitignore")
+			}
+		} else if cfg.GitInit {
+			opts.GitInit = true
+			if len(s.Services) == 1 {
+				opts.GitRemote = gitRemote(cfg, svc.Name)
+			} else if cfg.GitRemotePrefix != "" {
+				opts.GitRemote = cfg.GitRemotePrefix + "/" + svc.Name + ".git"
+			}
+		}
+		planned = append(planned, plannedService{opts: opts, dir: dir, dependsOn: svc.DependsOn})
+	}
+
+	// พอร์ตที่ระบุใน spec จองไว้ก่อน ที่เหลือเริ่มจากค่าเริ่มต้นของ framework แล้วเลื่อนทีละหนึ่ง
+	used := map[int]bool{}
+	for _, svc := range s.Services {
+		used[svc.Port] = svc.Port != 0
+	}
+	for i, svc := range s.Services {
+		port := defaultPort(planned[i].opts)
+		if svc.Port != 0 || port == 0 {
+			continue
+		}
+		for used[port] {
+			port++
+		}
+		used[port] = true
+		planned[i].opts.Framework.Port = port
+	}
+	return planned
+}
+
+// applyPort แทนพอร์ตเริ่มต้นของแค็ตตาล็อกในคำสั่ง start/serve/build ด้วยพอร์ตที่กำหนดให้ framework
+// เช่น uvicorn --port 8000 หรือ vite preview --port 5173 เมื่อพอร์ตถูกเลื่อนเพื่อไม่ให้ชนกับ service อื่น
+func applyPort(opts ui.ProjectOptions) ui.ProjectOptions {
+	fw, _, ok := config.FindFramework(opts.Framework.Name)
+	if !ok {
+		return opts
+	}
+	from, to := defaultPort(ui.ProjectOptions{Framework: fw}), defaultPort(opts)
+	if from == 0 || to == 0 || from == to {
+		return opts
+	}
+	re := regexp.MustCompile(`\b` + strconv.Itoa(from) + `\b`)
+	replace := func(s string) string { return re.ReplaceAllString(s, strconv.Itoa(to)) }
+	opts.Framework.StartCmd = replace(opts.Framework.StartCmd)
+	opts.Framework.ServeCmd = replace(opts.Framework.ServeCmd)
+	opts.Framework.BuildCmd = replace(opts.Framework.BuildCmd)
+	return opts
+}
+
+// servicesCompose docker-compose.yml ที่ build ทุก service ที่เป็น server (CLI ไม่อยู่ในไฟล์นี้)
+func servicesCompose(planned []plannedService) string {
+	var sb strings.Builder
+	for _, p := range planned {
+		port := defaultPort(p.opts)
+		if port == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s:\n    build: ./%s\n    ports:\n      - \"%d:%d\"\n    environment:\n      - PORT=%d\n",
+			toKebab(p.opts.Name), p.dir, port, port, port)
+		if len(p.dependsOn) > 0 {
+			sb.WriteString("    depends_on:\n")
+			for _, dep := range p.dependsOn {
+				fmt.Fprintf(&sb, "      - %s\n", toKebab(dep))
+			}
+		}
+		sb.WriteString("    restart: unless-stopped\n")
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	return "services:\n" + sb.String()
+}
+
+// servicesReadme README.md ระดับ root ที่เป็นสารบัญของทุก service
+func servicesReadme(s *spec.Spec, root string, planned []plannedService) string {
+	title := s.Name
+	if title == "" {
+		title = filepath.Base(root)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\nสร้างด้วย projgen จากไฟล์ spec (%d services)\n\n", title, len(planned))
+	sb.WriteString("| Service | Framework | Port | Path | Start |\n| ------- | --------- | ---- | ---- | ----- |\n")
+	compose := false
+	for _, p := range planned {
+		opts := applyVariant(p.opts)
+		port := "-"
+		if n := defaultPort(opts); n != 0 {
+			port, compose = strconv.Itoa(n), true
+		}
+		fmt.Fprintf(&sb, "| [%s](%s/) | %s | %s | `%s/` | `%s` |\n", opts.Name, p.dir, opts.Framework.DisplayName, port, p.dir, opts.Framework.StartCmd)
+	}
+	if compose {
+		sb.WriteString("\n## 🐳 Docker Compose\n\nรันทุก service พร้อมกัน (แต่ละ service ได้รับพอร์ตผ่าน `PORT`):\n\n```bash\ndocker compose up --build\n```\n")
+	}
+	return sb.String()
+}
+
+// printServicesSummary สรุป service ที่สร้างพร้อมพอร์ตหลังสร้างครบทุกตัว
+func printServicesSummary(root string, planned []plannedService) {
+	data := pterm.TableData{{"Service", "Framework", "Port", "Path"}}
+	for _, p := range planned {
+		port := "-"
+		if n := defaultPort(p.opts); n != 0 {
+			port = strconv.Itoa(n)
+		}
+		data = append(data, []string{p.opts.Name, p.opts.Framework.DisplayName, port, p.dir})
+	}
+	pterm.Println()
+	pterm.Success.Printfln("สร้าง %d services ใน %s เรียบร้อยแล้ว", len(planned), root)
+	_ = pterm.DefaultTable.WithHasHeader().WithData(data).Render()
+	pterm.Info.Printfln("รันทุก service ด้วย: %s", pterm.Cyan("docker compose up --build"))
+}
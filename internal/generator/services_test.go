@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/spec"
+)
+
+// พอร์ตที่ระบุใน spec ถูกจองก่อน service ที่ไม่ระบุจะเลื่อนจากค่าเริ่มต้นของ framework จนไม่ชนกัน
+func TestPlanServicesPorts(t *testing.T) {
+	tests := []struct {
+		name     string
+		services []spec.Service
+		want     []int // พอร์ตของแต่ละ service ตามลำดับ (0 = ไม่ใช่ server)
+	}{
+		{
+			name:     "defaults do not collide",
+			services: []spec.Service{{Name: "a", Framework: "go-gin"}, {Name: "b", Framework: "go-chi"}, {Name: "c", Framework: "go-fiber"}},
+			want:     []int{8080, 8081, 8082},
+		},
+		{
+			name:     "explicit port is reserved first",
+			services: []spec.Service{{Name: "a", Framework: "go-gin"}, {Name: "b", Framework: "go-chi", Port: 8080}},
+			want:     []int{8081, 8080},
+		},
+		{
+			name:     "shifted port skips explicit ports",
+			services: []spec.Service{{Name: "a", Framework: "go-gin"}, {Name: "b", Framework: "go-chi"}, {Name: "c", Framework: "fastapi-api", Port: 8081}},
+			want:     []int{8080, 8082, 8081},
+		},
+		{
+			name:     "different defaults",
+			services: []spec.Service{{Name: "api", Framework: "go-gin"}, {Name: "ml", Framework: "fastapi-api"}, {Name: "tool", Framework: "go-cli"}},
+			want:     []int{8080, 8000, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := planServices(&spec.Spec{Name: "shop", Layout: spec.LayoutRepo, Services: tt.services}, config.Defaults())
+			got := make([]int, len(planned))
+			for i, p := range planned {
+				got[i] = defaultPort(p.opts)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ports = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// layout repo วาง service ใต้ services/ และใช้ git repository เดียวที่ root
+// layout siblings วาง service ข้างกันและให้แต่ละ service มี repository ของตัวเอง
+func TestPlanServicesLayout(t *testing.T) {
+	pterm.DisableOutput()
+	defer pterm.EnableOutput()
+
+	services := []spec.Service{{Name: "api", Framework: "go-gin", Extras: []string{"env"}}, {Name: "tool", Framework: "go-cli"}}
+	tests := []struct {
+		name        string
+		layout      spec.Layout
+		gitInit     bool
+		wantDirs    []string
+		wantExtras  [][]string
+		wantGitInit bool
+		wantRemotes []string
+	}{
+		{
+			name:        "repo",
+			layout:      spec.LayoutRepo,
+			gitInit:     true,
+			wantDirs:    []string{"services/api", "services/tool"},
+			wantExtras:  [][]string{{"env", "dockerfile", "gitignore"}, {"gitignore"}},
+			wantRemotes: []string{"", ""},
+		},
+		{
+			name:        "repo without git",
+			layout:      spec.LayoutRepo,
+			wantDirs:    []string{"services/api", "services/tool"},
+			wantExtras:  [][]string{{"env", "dockerfile"}, nil},
+			wantRemotes: []string{"", ""},
+		},
+		{
+			name:        "siblings",
+			layout:      spec.LayoutSiblings,
+			gitInit:     true,
+			wantDirs:    []string{"api", "tool"},
+			wantExtras:  [][]string{{"env", "dockerfile"}, nil},
+			wantGitInit: true,
+			wantRemotes: []string{"git@github.com:acme/api.git", "git@github.com:acme/tool.git"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Defaults()
+			cfg.GitInit = tt.gitInit
+			cfg.GitRemotePrefix = "git@github.com:acme"
+			planned := planServices(&spec.Spec{Name: "shop", Layout: tt.layout, Services: services}, cfg)
+			for i, p := range planned {
+				if p.dir != tt.wantDirs[i] {
+					t.Errorf("%s: dir = %q, want %q", p.opts.Name, p.dir, tt.wantDirs[i])
+				}
+				if !reflect.DeepEqual(p.opts.Extras, tt.wantExtras[i]) {
+					t.Errorf("%s: extras = %v, want %v", p.opts.Name, p.opts.Extras, tt.wantExtras[i])
+				}
+				if p.opts.GitInit != tt.wantGitInit {
+					t.Errorf("%s: GitInit = %v, want %v", p.opts.Name, p.opts.GitInit, tt.wantGitInit)
+				}
+				if p.opts.GitRemote != tt.wantRemotes[i] {
+					t.Errorf("%s: GitRemote = %q, want %q", p.opts.Name, p.opts.GitRemote, tt.wantRemotes[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package generator
+
+// รายงาน drift ของโปรเจ็กต์เทียบกับเทมเพลตต้นทาง
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/manifest"
+	"projgen/internal/templates"
+)
+
+// StatusReport ผลการเปรียบเทียบโปรเจ็กต์กับเทมเพลต
+type StatusReport struct {
+	Template        string   `json:"template"`
+	TemplateChanged bool     `json:"templateChanged"` // เทมเพลตเปลี่ยนไปจาก hash ที่บันทึกไว้
+	Modified        []string `json:"modified"`        // ไฟล์ที่ผู้ใช้แก้ไข
+	Deleted         []string `json:"deleted"`         // ไฟล์ที่ผู้ใช้ลบ
+	Outdated        []string `json:"outdated"`        // ไฟล์ที่เทมเพลตปัจจุบันให้ผลต่างจากตอนสร้าง
+	Added           []string `json:"added"`           // ไฟล์ใหม่ในเทมเพลตที่โปรเจ็กต์ยังไม่มี
+	Unchanged       int      `json:"unchanged"`       // ไฟล์ที่ตรงกับตอนสร้างทุกประการ
+}
+
+// Status เปรียบเทียบไฟล์ในโปรเจ็กต์กับ manifest และกับผลการเรนเดอร์เทมเพลตในปัจจุบัน
+func Status(ctx context.Context, dir string, cfg *config.Config) (*StatusReport, error) {
+	m, err := manifest.Read(dir)
+	if err != nil {
+		return nil, err
+	}
+	tmplDir, err := resolveRecordedTemplate(m, cfg.TemplateSources)
+	if err != nil {
+		return nil, err
+	}
+	current, _, err := renderSnapshot(tmplDir, m.Options, cfg.TemplateSources)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StatusReport{
+		Template: m.Template.Path,
+		Modified: []string{},
+		Deleted:  []string{},
+		Outdated: []string{},
+		Added:    []string{},
+	}
+	if tmplDir != "" && m.Template.Hash != "" {
+		if h, err := templates.Hash(tmplDir); err == nil {
+			report.TemplateChanged = h != m.Template.Hash
+		}
+	}
+
+	for rel, recorded := range m.Files {
+		drift := false
+		h, err := manifest.HashFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			report.Deleted = append(report.Deleted, rel)
+			drift = true
+		case err != nil:
+			return nil, err
+		case !matchesRecorded(m, rel, h):
+			report.Modified = append(report.Modified, rel)
+			drift = true
+		}
+		if b, ok := current[rel]; ok && manifest.HashBytes(b) != recorded {
+			report.Outdated = append(report.Outdated, rel)
+			drift = true
+		}
+		if !drift {
+			report.Unchanged++
+		}
+	}
+	for rel := range current {
+		if _, ok := m.Files[rel]; !ok {
+			report.Added = append(report.Added, rel)
+		}
+	}
+
+	for _, list := range [][]string{report.Modified, report.Deleted, report.Outdated, report.Added} {
+		sort.Strings(list)
+	}
+	return report, nil
+}
+
+// matchesRecorded ตรวจว่า hash ตรงกับเนื้อหาที่ projgen เขียน: ตามที่เรนเดอร์ หรือหลังคำสั่งติดตั้งแก้ไฟล์
+func matchesRecorded(m *manifest.Manifest, rel, h string) bool {
+	if h == m.Files[rel] {
+		return true
+	}
+	installed, ok := m.Installed[rel]
+	return ok && h == installed
+}
+
+// recordInstalled บันทึก hash ใหม่ของไฟล์ที่คำสั่งติดตั้ง/ถอนแพ็กเกจเพิ่งแก้ (before คือ hash ก่อนรันคำสั่ง)
+// ไฟล์ที่ผู้ใช้แก้ไว้ก่อนแล้วยังนับเป็นการแก้ไขของผู้ใช้เหมือนเดิม
+func recordInstalled(m *manifest.Manifest, dir string, before map[string]string) error {
+	rels := make([]string, 0, len(before))
+	for rel := range before {
+		rels = append(rels, rel)
+	}
+	after, err := manifest.HashFiles(dir, rels)
+	if err != nil {
+		return err
+	}
+	for rel, h := range after {
+		if h == before[rel] || !matchesRecorded(m, rel, before[rel]) {
+			continue
+		}
+		if m.Installed == nil {
+			m.Installed = map[string]string{}
+		}
+		m.Installed[rel] = h
+	}
+	return nil
+}
+
+// trackedHashes hash ปัจจุบันของทุกไฟล์ที่ manifest บันทึกไว้ (ใช้คู่กับ recordInstalled)
+func trackedHashes(m *manifest.Manifest, dir string) (map[string]string, error) {
+	rels := make([]string, 0, len(m.Files))
+	for rel := range m.Files {
+		rels = append(rels, rel)
+	}
+	return manifest.HashFiles(dir, rels)
+}
+
+// Clean คืนค่าจริงเมื่อโปรเจ็กต์ไม่มี drift เลย
+func (r *StatusReport) Clean() bool {
+	return len(r.Modified)+len(r.Deleted)+len(r.Outdated)+len(r.Added) == 0
+}
+
+// PrintStatus แสดงรายงาน drift แบบอ่านง่าย
+func PrintStatus(r *StatusReport) {
+	pterm.DefaultSection.WithStyle(pterm.NewStyle(pterm.FgLightCyan)).Printfln("📊 สถานะเทียบกับเทมเพลต %s", r.Template)
+	if r.TemplateChanged {
+		pterm.Info.Println("เทมเพลตมีการเปลี่ยนแปลงหลังจากสร้าง/อัปเดตโปรเจ็กต์ครั้งล่าสุด")
+	}
+	if r.Clean() {
+		pterm.Success.Println("โปรเจ็กต์ตรงกับเทมเพลตทุกไฟล์")
+		return
+	}
+
+	groups := []struct {
+		title string
+		files []string
+		color func(a ...any) string
+	}{
+		{"แก้ไขโดยผู้ใช้", r.Modified, pterm.Yellow},
+		{"ถูกลบ", r.Deleted, pterm.Red},
+		{"เทมเพลตใหม่กว่า", r.Outdated, pterm.Cyan},
+		{"ไฟล์ใหม่ในเทมเพลต", r.Added, pterm.Green},
+	}
+	for _, g := range groups {
+		if len(g.files) == 0 {
+			continue
+		}
+		pterm.Println(pterm.Bold.Sprintf("%s (%d)", g.title, len(g.files)))
+		for _, f := range g.files {
+			pterm.Println("   " + g.color(f))
+		}
+	}
+	pterm.Println()
+	pterm.Info.Printfln("ไม่เปลี่ยนแปลง %d ไฟล์ — ใช้ %s เพื่อนำการเปลี่ยนแปลงของเทมเพลตมาใช้", r.Unchanged, pterm.Cyan("projgen update"))
+}
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"projgen/internal/ui"
+)
+
+// โปรเจ็กต์ที่เพิ่งสร้าง (รวมคำสั่งติดตั้งที่แก้ go.mod/package.json) ต้องไม่มี drift
+// แต่การแก้ไฟล์เดียวกันโดยผู้ใช้หลังจากนั้นต้องถูกรายงาน
+func TestStatusCleanAfterGenerate(t *testing.T) {
+	tests := []struct {
+		framework string
+		install   string // คำสั่งติดตั้งที่ใช้แทนของจริง (แก้ไฟล์ได้โดยไม่ต้องใช้เครือข่าย)
+		extras    []string
+		edit      string // ไฟล์ที่คำสั่งติดตั้งแก้ ซึ่งผู้ใช้แก้ต่อ
+	}{
+		{"go-fiber", "go mod edit -require=example.com/dep@v1.0.0", []string{"dockerfile", "env"}, "go.mod"},
+		{"express-api", "npm pkg set dependencies.left-pad=1.3.0", []string{"gitignore", "github-actions"}, "package.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.framework, func(t *testing.T) {
+			bin := strings.Fields(tt.install)[0]
+			if _, err := exec.LookPath(bin); err != nil {
+				t.Skipf("ไม่พบ %s", bin)
+			}
+			dir, cfg := generateFixture(t, tt.framework, func(opts *ui.ProjectOptions) {
+				opts.Framework.InstallCmd = tt.install
+				opts.Extras = tt.extras
+				opts.AutoInstall = true
+			})
+			ctx := context.Background()
+
+			report, err := Status(ctx, dir, cfg)
+			if err != nil {
+				t.Fatalf("Status: %v", err)
+			}
+			if !report.Clean() {
+				t.Errorf("โปรเจ็กต์ที่เพิ่งสร้างมี drift: modified=%v deleted=%v outdated=%v added=%v",
+					report.Modified, report.Deleted, report.Outdated, report.Added)
+			}
+
+			path := filepath.Join(dir, tt.edit)
+			b, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if report, err = Status(ctx, dir, cfg); err != nil {
+				t.Fatalf("Status: %v", err)
+			}
+			if !contains(report.Modified, tt.edit) {
+				t.Errorf("ไม่รายงาน %s ที่ผู้ใช้แก้: modified=%v", tt.edit, report.Modified)
+			}
+		})
+	}
+}
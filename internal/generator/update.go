@@ -0,0 +1,422 @@
+package generator
+
+// อัปเดตโปรเจ็กต์ที่สร้างไว้แล้วให้ตรงกับเทมเพลตเวอร์ชันใหม่ด้วย three-way merge
+// base = ผลการเรนเดอร์เทมเพลตเวอร์ชันเดิม (จาก git commit ใน manifest)
+// ours = ไฟล์ปัจจุบันใน working tree, theirs = ผลการเรนเดอร์เทมเพลตเวอร์ชันปัจจุบัน
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"projgen/internal/config"
+	"projgen/internal/manifest"
+	"projgen/internal/templates"
+	"projgen/internal/ui"
+)
+
+// ผลลัพธ์ของแต่ละไฟล์ระหว่างอัปเดต
+const (
+	ActionUnchanged = "unchanged" // ไม่มีอะไรเปลี่ยน
+	ActionUpdated   = "updated"   // ผู้ใช้ไม่ได้แก้ไข แทนที่ด้วยเวอร์ชันใหม่
+	ActionAdded     = "added"     // ไฟล์ใหม่จากเทมเพลต
+	ActionMerged    = "merged"    // merge การแก้ไขของผู้ใช้กับเทมเพลตได้โดยไม่ชนกัน
+	ActionConflict  = "conflict"  // ชนกัน เขียน conflict markers หรือไฟล์ .rej
+	ActionRemoved   = "removed"   // เทมเพลตเลิกใช้ไฟล์นี้และผู้ใช้ไม่ได้แก้ไข
+	ActionKept      = "kept"      // เก็บไฟล์ของผู้ใช้ไว้ (ผู้ใช้ลบหรือแก้ไขไฟล์ที่เทมเพลตเลิกใช้)
+)
+
+// FileChange การเปลี่ยนแปลงของไฟล์หนึ่งไฟล์
+type FileChange struct {
+	Path   string
+	Action string
+	Note   string // รายละเอียดเพิ่มเติม เช่น ชื่อไฟล์ .rej
+	Diff   string // unified diff ระหว่างไฟล์ปัจจุบันกับผลลัพธ์ (ใช้กับ dry-run)
+}
+
+// UpdateOptions ตัวเลือกของการอัปเดต
+type UpdateOptions struct {
+	DryRun bool // แสดงผลอย่างเดียว ไม่เขียนไฟล์
+}
+
+// Update เรนเดอร์เทมเพลตเวอร์ชันเดิมและเวอร์ชันปัจจุบันด้วยตัวเลือกใน manifest
+// แล้ว merge การเปลี่ยนแปลงเข้าสู่โปรเจ็กต์ใน dir
+func Update(ctx context.Context, dir string, cfg *config.Config, uopts UpdateOptions) ([]FileChange, error) {
+	m, err := manifest.Read(dir)
+	if err != nil {
+		return nil, err
+	}
+	opts := m.Options
+
+	newTmpl, err := resolveRecordedTemplate(m, cfg.TemplateSources)
+	if err != nil {
+		return nil, err
+	}
+
+	theirs, extras, err := renderSnapshot(newTmpl, opts, cfg.TemplateSources)
+	if err != nil {
+		return nil, fmt.Errorf("เรนเดอร์เทมเพลตเวอร์ชันปัจจุบันไม่สำเร็จ: %w", err)
+	}
+	base, err := baseSnapshot(ctx, newTmpl, m, cfg.TemplateSources)
+	if err != nil {
+		pterm.Warning.Printfln("ไม่สามารถสร้างเทมเพลตเวอร์ชันเดิมได้ (%v) — จะใช้ hash ใน manifest ตัดสินแทน", err)
+		base = nil
+	}
+
+	paths := map[string]bool{}
+	for p := range theirs {
+		paths[p] = true
+	}
+	for p := range base {
+		paths[p] = true
+	}
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+
+	// ไฟล์ที่ยังตรงกับ hash หลังติดตั้ง (ผู้ใช้ไม่ได้แก้) ให้บันทึก hash หลังอัปเดตแทน
+	installed := map[string]bool{}
+	for rel, h := range m.Installed {
+		if cur, err := manifest.HashFile(filepath.Join(dir, filepath.FromSlash(rel))); err == nil && cur == h {
+			installed[rel] = true
+		}
+	}
+
+	var changes []FileChange
+	for _, rel := range sorted {
+		change, err := updateFile(ctx, dir, rel, base, theirs, m, uopts.DryRun)
+		if err != nil {
+			return changes, fmt.Errorf("%s: %w", rel, err)
+		}
+		changes = append(changes, change)
+	}
+
+	if uopts.DryRun {
+		return changes, nil
+	}
+
+	// บันทึก manifest ใหม่: hash ของไฟล์อ้างอิงผลการเรนเดอร์เทมเพลตเวอร์ชันปัจจุบัน
+	m.ProjgenVersion = config.Version
+	m.UpdatedAt = time.Now().UTC()
+	m.Files = make(map[string]string, len(theirs))
+	for rel, b := range theirs {
+		m.Files[rel] = manifest.HashBytes(b)
+	}
+	m.Extras = extras
+	m.Installed = nil
+	for rel := range installed {
+		h, err := manifest.HashFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil || m.Files[rel] == "" || h == m.Files[rel] {
+			continue
+		}
+		if m.Installed == nil {
+			m.Installed = map[string]string{}
+		}
+		m.Installed[rel] = h
+	}
+	if err := recordTemplates(ctx, m, newTmpl, cfg.TemplateSources); err != nil {
+		return changes, err
+	}
+	return changes, manifest.Write(dir, m)
+}
+
+// updateFile ตัดสินใจและ (ถ้าไม่ใช่ dry-run) เขียนผลลัพธ์ของไฟล์เดียว
+func updateFile(ctx context.Context, dir, rel string, base, theirs map[string][]byte, m *manifest.Manifest, dryRun bool) (FileChange, error) {
+	change := FileChange{Path: rel}
+	path := filepath.Join(dir, filepath.FromSlash(rel))
+	ours, err := os.ReadFile(path)
+	oursExists := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return change, err
+	}
+	newB, inNew := theirs[rel]
+	baseB, inBase := base[rel]
+
+	// ผู้ใช้ยังไม่ได้แก้ไขไฟล์นี้หรือไม่ (เทียบกับ base ถ้ามี ไม่งั้นเทียบ hash ใน manifest)
+	pristine := false
+	if oursExists {
+		if base != nil && inBase {
+			pristine = bytes.Equal(ours, baseB)
+		} else if h, ok := m.Files[rel]; ok {
+			pristine = h == manifest.HashBytes(ours)
+		}
+	}
+
+	switch {
+	case !inNew:
+		// เทมเพลตเลิกใช้ไฟล์นี้
+		if oursExists && pristine {
+			change.Action = ActionRemoved
+			change.Diff = unifiedDiff(rel, ours, nil)
+			if !dryRun {
+				return change, os.Remove(path)
+			}
+			return change, nil
+		}
+		change.Action = ActionKept
+		return change, nil
+
+	case !oursExists:
+		if _, tracked := m.Files[rel]; tracked {
+			// ผู้ใช้ลบไฟล์นี้เอง ไม่สร้างกลับมา
+			change.Action = ActionKept
+			change.Note = "ถูกลบโดยผู้ใช้"
+			return change, nil
+		}
+		change.Action = ActionAdded
+		change.Diff = unifiedDiff(rel, nil, newB)
+		if !dryRun {
+			return change, writeProjectFile(path, newB)
+		}
+		return change, nil
+
+	case bytes.Equal(ours, newB):
+		change.Action = ActionUnchanged
+		return change, nil
+
+	case pristine:
+		change.Action = ActionUpdated
+		change.Diff = unifiedDiff(rel, ours, newB)
+		if !dryRun {
+			return change, writeProjectFile(path, newB)
+		}
+		return change, nil
+
+	case inBase && bytes.Equal(baseB, newB):
+		// เทมเพลตไม่ได้เปลี่ยนไฟล์นี้ เก็บการแก้ไขของผู้ใช้ไว้
+		change.Action = ActionUnchanged
+		return change, nil
+
+	case !inBase && m.Files[rel] == manifest.HashBytes(newB):
+		// ไม่มี base แต่เทมเพลตให้ผลเหมือนที่บันทึกไว้ตอนสร้าง: ความต่างทั้งหมดเป็นการแก้ไขของผู้ใช้
+		change.Action = ActionUnchanged
+		return change, nil
+	}
+
+	// ผู้ใช้แก้ไขและเทมเพลตก็เปลี่ยน: three-way merge
+	if inBase {
+		merged, clean, err := mergeFile(ctx, ours, baseB, newB)
+		if err == nil {
+			change.Action = ActionMerged
+			if !clean {
+				change.Action = ActionConflict
+				change.Note = "มี conflict markers"
+			}
+			change.Diff = unifiedDiff(rel, ours, merged)
+			if !dryRun {
+				return change, writeProjectFile(path, merged)
+			}
+			return change, nil
+		}
+	}
+
+	// merge ไม่ได้ (ไม่มี base หรือไม่มี git): เขียนเวอร์ชันใหม่ไว้ข้าง ๆ เป็น .rej
+	change.Action = ActionConflict
+	change.Note = rel + ".rej"
+	change.Diff = unifiedDiff(rel, ours, newB)
+	if !dryRun {
+		return change, writeProjectFile(path+".rej", []byte(change.Diff))
+	}
+	return change, nil
+}
+
+// resolveRecordedTemplate ค้นหาเทมเพลตที่ manifest อ้างถึง
+// ลองค้นตาม path ในแค็ตตาล็อกก่อน แล้วจึงใช้โฟลเดอร์ที่บันทึกไว้ตอนสร้าง
+func resolveRecordedTemplate(m *manifest.Manifest, sources []string) (string, error) {
+	if m.Template.Path == "" {
+		return "", nil
+	}
+	if dir := templates.Resolve(m.Template.Path, sources); dir != "" {
+		return dir, nil
+	}
+	if m.Template.Source != "" {
+		if fi, err := os.Stat(m.Template.Source); err == nil && fi.IsDir() {
+			return m.Template.Source, nil
+		}
+	}
+	return "", fmt.Errorf("ไม่พบเทมเพลต %s (ตั้งค่า template-sources ให้ชี้ไปที่โฟลเดอร์เทมเพลต)", m.Template.Path)
+}
+
+// renderSnapshot เรนเดอร์เทมเพลตพร้อม extras ลงโฟลเดอร์ชั่วคราว คืนเนื้อหาทุกไฟล์และไฟล์ที่แต่ละ extra เขียน
+// sources ใช้ค้นหาเทมเพลตของแอปย่อยใน monorepo
+func renderSnapshot(tmplDir string, opts ui.ProjectOptions, sources []string) (map[string][]byte, map[string][]string, error) {
+	tmp, err := os.MkdirTemp("", "projgen-render-")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	opts = applyVariant(opts)
+	w := newWriter(tmp)
+	if tmplDir != "" {
+		err = renderProject(w, tmplDir, opts, sources)
+	} else {
+		err = generateFallbackSkeleton(w, opts)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := generateExtras(w, opts); err != nil {
+		return nil, nil, err
+	}
+
+	out := make(map[string][]byte, len(w.files))
+	for _, rel := range w.files {
+		b, err := os.ReadFile(filepath.Join(tmp, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, nil, err
+		}
+		out[rel] = b
+	}
+	return out, w.extras, nil
+}
+
+// baseSnapshot เรนเดอร์เทมเพลตเวอร์ชันที่ใช้สร้างโปรเจ็กต์ (ตาม commit ใน manifest)
+// เทมเพลตของแอปย่อย (monorepo, frontend ฐานของ Tauri) ใช้ commit ใน m.Apps
+// manifest รุ่นก่อนที่ไม่มี m.Apps ใช้ commit ของเทมเพลตหลัก (เทมเพลตอยู่ใน repository เดียวกัน)
+func baseSnapshot(ctx context.Context, tmplDir string, m *manifest.Manifest, sources []string) (map[string][]byte, error) {
+	if tmplDir == "" || m.Template.Commit == "" {
+		return nil, errors.New("manifest ไม่มี commit ของเทมเพลต")
+	}
+	old, err := exportTemplate(ctx, tmplDir, m.Template)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(old)
+
+	opts := withFrontendBase(m.Options)
+	if isMonorepo(opts) || opts.Framework.FrontendBase {
+		for _, app := range []**config.FrameworkOption{&opts.Frontend, &opts.Backend} {
+			if *app == nil {
+				continue
+			}
+			fw := **app
+			rec := manifest.Template{Path: fw.TemplatePath, Commit: m.Template.Commit}
+			for _, a := range m.Apps {
+				if a.Path == fw.TemplatePath {
+					rec = a
+				}
+			}
+			dir := resolveTemplateDir(ui.ProjectOptions{Framework: fw}, sources)
+			if dir == "" {
+				return nil, missingTemplateError(fw)
+			}
+			appDir, err := exportTemplate(ctx, dir, rec)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fw.Name, err)
+			}
+			defer os.RemoveAll(appDir)
+			// path แบบ absolute ทำให้ resolveTemplateDir ใช้เนื้อหาที่ export ไว้แทนเทมเพลตปัจจุบัน
+			fw.TemplatePath = appDir
+			*app = &fw
+		}
+	}
+	files, _, err := renderSnapshot(old, opts, sources)
+	return files, err
+}
+
+// exportTemplate ดึงเนื้อหาเทมเพลตใน dir ณ commit ที่บันทึกไว้ แล้วตรวจกับ hash ใน manifest
+// ผู้เรียกต้องลบโฟลเดอร์ที่คืนไปเอง
+func exportTemplate(ctx context.Context, dir string, rec manifest.Template) (string, error) {
+	old, err := templates.Export(ctx, dir, rec.Commit)
+	if err != nil {
+		return "", err
+	}
+	// commit อาจไม่ตรงกับเนื้อหาจริงหากตอนสร้างเทมเพลตมีการแก้ไขที่ยังไม่ commit
+	if rec.Hash != "" {
+		if h, err := templates.Hash(old); err == nil && h != rec.Hash {
+			os.RemoveAll(old)
+			return "", fmt.Errorf("เนื้อหาเทมเพลตที่ commit %.12s ไม่ตรงกับ hash ใน manifest", rec.Commit)
+		}
+	}
+	return old, nil
+}
+
+// mergeFile three-way merge ด้วย git merge-file; clean=false เมื่อมี conflict markers
+func mergeFile(ctx context.Context, ours, base, theirs []byte) ([]byte, bool, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, false, err
+	}
+	tmp, err := os.MkdirTemp("", "projgen-merge-")
+	if err != nil {
+		return nil, false, err
+	}
+	defer os.RemoveAll(tmp)
+
+	files := []struct {
+		name string
+		data []byte
+	}{{"current", ours}, {"base", base}, {"template", theirs}}
+	args := []string{"merge-file", "-p", "-L", "current", "-L", "base", "-L", "template"}
+	for _, f := range files {
+		p := filepath.Join(tmp, f.name)
+		if err := os.WriteFile(p, f.data, 0o644); err != nil {
+			return nil, false, err
+		}
+		args = append(args, p)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return stdout.Bytes(), true, nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() > 0:
+		// exit code บวก = จำนวน conflicts
+		return stdout.Bytes(), false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func writeProjectFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// PrintChanges แสดงสรุปการเปลี่ยนแปลง พร้อม diff เมื่อ showDiff เป็นจริง
+func PrintChanges(changes []FileChange, showDiff bool) {
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.Action]++
+		if c.Action == ActionUnchanged {
+			continue
+		}
+		label := fmt.Sprintf("%-10s", c.Action)
+		switch c.Action {
+		case ActionConflict:
+			label = pterm.Red(label)
+		case ActionMerged, ActionUpdated, ActionAdded:
+			label = pterm.Green(label)
+		case ActionRemoved, ActionKept:
+			label = pterm.Yellow(label)
+		}
+		line := label + " " + c.Path
+		if c.Note != "" {
+			line += pterm.Gray(" (" + c.Note + ")")
+		}
+		pterm.Println("   " + line)
+		if showDiff && c.Diff != "" {
+			printDiff(c.Diff)
+		}
+	}
+	pterm.Println()
+	pterm.Info.Printfln("updated %d, added %d, merged %d, conflict %d, removed %d, kept %d, unchanged %d",
+		counts[ActionUpdated], counts[ActionAdded], counts[ActionMerged], counts[ActionConflict],
+		counts[ActionRemoved], counts[ActionKept], counts[ActionUnchanged])
+}
@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"projgen/internal/manifest"
+)
+
+func TestUpdateFile(t *testing.T) {
+	const (
+		orig     = "a\nb\nc\nd\ne\n"
+		userEdit = "a\nb\nc\nd\ne\n// user\n"      // ผู้ใช้เพิ่มบรรทัดท้ายไฟล์
+		tmplEdit = "a (v2)\nb\nc\nd\ne\n"          // เทมเพลตแก้บรรทัดแรก
+		merged   = "a (v2)\nb\nc\nd\ne\n// user\n" // ผลของ merge สองฝั่งที่ไม่ชนกัน
+		conflict = "A\nb\nc\nd\ne\n"               // ผู้ใช้แก้บรรทัดเดียวกับเทมเพลต
+	)
+	_, gitErr := exec.LookPath("git")
+
+	tests := []struct {
+		name       string
+		ours       *string // nil = ไม่มีไฟล์ในโปรเจ็กต์
+		base       *string // nil = ไม่มีใน base
+		noBase     bool    // เรนเดอร์เทมเพลตเวอร์ชันเดิมไม่ได้เลย (base == nil)
+		theirs     *string // nil = เทมเพลตเลิกใช้ไฟล์นี้
+		recorded   *string // เนื้อหาที่ hash ไว้ใน manifest (nil = ไม่ได้บันทึก)
+		needsGit   bool
+		wantAction string
+		wantFile   *string // nil = ไฟล์ต้องไม่มีอยู่
+		wantRej    bool
+	}{
+		{name: "pristine unchanged", ours: str(orig), base: str(orig), theirs: str(orig), recorded: str(orig),
+			wantAction: ActionUnchanged, wantFile: str(orig)},
+		{name: "template-only change", ours: str(orig), base: str(orig), theirs: str(tmplEdit), recorded: str(orig),
+			wantAction: ActionUpdated, wantFile: str(tmplEdit)},
+		{name: "user-only change", ours: str(userEdit), base: str(orig), theirs: str(orig), recorded: str(orig),
+			wantAction: ActionUnchanged, wantFile: str(userEdit)},
+		{name: "both changed", ours: str(userEdit), base: str(orig), theirs: str(tmplEdit), recorded: str(orig), needsGit: true,
+			wantAction: ActionMerged, wantFile: str(merged)},
+		{name: "both changed same line", ours: str(conflict), base: str(orig), theirs: str(tmplEdit), recorded: str(orig), needsGit: true,
+			wantAction: ActionConflict},
+		{name: "no base user-only change", ours: str(userEdit), noBase: true, theirs: str(orig), recorded: str(orig),
+			wantAction: ActionUnchanged, wantFile: str(userEdit)},
+		{name: "no base pristine template change", ours: str(orig), noBase: true, theirs: str(tmplEdit), recorded: str(orig),
+			wantAction: ActionUpdated, wantFile: str(tmplEdit)},
+		{name: "no base both changed", ours: str(userEdit), noBase: true, theirs: str(tmplEdit), recorded: str(orig),
+			wantAction: ActionConflict, wantFile: str(userEdit), wantRej: true},
+		{name: "new in template", base: nil, theirs: str(orig),
+			wantAction: ActionAdded, wantFile: str(orig)},
+		{name: "removed by template pristine", ours: str(orig), base: str(orig), recorded: str(orig),
+			wantAction: ActionRemoved},
+		{name: "removed by template user edited", ours: str(userEdit), base: str(orig), recorded: str(orig),
+			wantAction: ActionKept, wantFile: str(userEdit)},
+		{name: "removed by user", base: str(orig), theirs: str(tmplEdit), recorded: str(orig),
+			wantAction: ActionKept},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.needsGit && gitErr != nil {
+				t.Skip("ไม่พบ git")
+			}
+			const rel = "main.go"
+			dir := t.TempDir()
+			path := filepath.Join(dir, rel)
+			if tt.ours != nil {
+				if err := os.WriteFile(path, []byte(*tt.ours), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var base map[string][]byte
+			if !tt.noBase {
+				base = map[string][]byte{}
+				if tt.base != nil {
+					base[rel] = []byte(*tt.base)
+				}
+			}
+			theirs := map[string][]byte{}
+			if tt.theirs != nil {
+				theirs[rel] = []byte(*tt.theirs)
+			}
+			m := &manifest.Manifest{Files: map[string]string{}}
+			if tt.recorded != nil {
+				m.Files[rel] = manifest.HashBytes([]byte(*tt.recorded))
+			}
+
+			change, err := updateFile(context.Background(), dir, rel, base, theirs, m, false)
+			if err != nil {
+				t.Fatalf("updateFile: %v", err)
+			}
+			if change.Action != tt.wantAction {
+				t.Errorf("action = %q, want %q", change.Action, tt.wantAction)
+			}
+
+			got, err := os.ReadFile(path)
+			switch {
+			case tt.wantFile == nil && tt.wantAction != ActionConflict:
+				if !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("ไฟล์ยังอยู่ (err=%v) เนื้อหา %q", err, got)
+				}
+			case tt.wantFile != nil:
+				if err != nil {
+					t.Fatalf("อ่านไฟล์: %v", err)
+				}
+				if string(got) != *tt.wantFile {
+					t.Errorf("เนื้อหา = %q, want %q", got, *tt.wantFile)
+				}
+			case tt.wantAction == ActionConflict:
+				if !strings.Contains(string(got), "<<<<<<< current") {
+					t.Errorf("ไม่มี conflict markers: %q", got)
+				}
+			}
+			if _, err := os.Stat(path + ".rej"); (err == nil) != tt.wantRej {
+				t.Errorf(".rej exists = %v, want %v", err == nil, tt.wantRej)
+			}
+		})
+	}
+}
+
+func TestMergeFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("ไม่พบ git")
+	}
+	ctx := context.Background()
+	base := []byte("one\ntwo\nthree\nfour\nfive\n")
+
+	out, clean, err := mergeFile(ctx, []byte("one\ntwo\nthree\nfour\nfive\nsix\n"), base, []byte("ONE\ntwo\nthree\nfour\nfive\n"))
+	if err != nil || !clean {
+		t.Fatalf("merge ไม่ชนกัน: clean=%v err=%v", clean, err)
+	}
+	if want := "ONE\ntwo\nthree\nfour\nfive\nsix\n"; string(out) != want {
+		t.Errorf("merged = %q, want %q", out, want)
+	}
+
+	out, clean, err = mergeFile(ctx, []byte("uno\ntwo\nthree\nfour\nfive\n"), base, []byte("ONE\ntwo\nthree\nfour\nfive\n"))
+	if err != nil || clean {
+		t.Fatalf("merge ที่ชนกัน: clean=%v err=%v", clean, err)
+	}
+	for _, marker := range []string{"<<<<<<< current", "=======", ">>>>>>> template"} {
+		if !strings.Contains(string(out), marker) {
+			t.Errorf("ไม่มี %q ในผลลัพธ์: %q", marker, out)
+		}
+	}
+}
+
+func str(s string) *string { return &s }
@@ -0,0 +1,97 @@
+package generator
+
+// รูปแบบโครงสร้างโปรเจ็กต์ (variants) เช่น Go แบบ flat หรือ cmd/<name> + internal/
+
+import (
+	"strings"
+
+	"projgen/internal/config"
+	"projgen/internal/ui"
+)
+
+// variantsDir โฟลเดอร์ในเทมเพลตที่เก็บไฟล์ของแต่ละรูปแบบ
+const variantsDir = "_variants"
+
+// applyVariant เลือกรูปแบบ (ค่าเริ่มต้นคือตัวแรก) และปรับรันไทม์และคำสั่ง install/start/build/main package ของ framework ตามรูปแบบนั้น
+// framework ที่ไม่มี variants จะถูกแทน __name__ ในคำสั่งของตัวเองเท่านั้น
+// สุดท้ายแทนพอร์ตในคำสั่งถ้า framework ถูกกำหนดพอร์ตอื่นจากค่าเริ่มต้น (ดู applyPort)
+func applyVariant(opts ui.ProjectOptions) ui.ProjectOptions {
+	variants := opts.Framework.Variants
+	// manifest รุ่นเก่าไม่มีรายการ variants ใช้ของแค็ตตาล็อกปัจจุบันแทน
+	if len(variants) == 0 {
+		if fw, _, ok := config.FindFramework(opts.Framework.Name); ok {
+			variants = fw.Variants
+		}
+	}
+	if len(variants) == 0 {
+		opts.Framework.MainPackage = expandName(opts.Framework.MainPackage, opts)
+		opts.Framework.StartCmd = expandName(opts.Framework.StartCmd, opts)
+		opts.Framework.BuildCmd = expandName(opts.Framework.BuildCmd, opts)
+		return applyPort(opts)
+	}
+	chosen := variants[0]
+	for _, v := range variants {
+		if v.Name == opts.Variant {
+			chosen = v
+		}
+	}
+	opts.Variant = chosen.Name
+	if chosen.Runtime != "" {
+		opts.Framework.Runtime = chosen.Runtime
+		opts.Runtime = chosen.Runtime
+	}
+	if chosen.MainPackage != "" {
+		opts.Framework.MainPackage = expandName(chosen.MainPackage, opts)
+	}
+	if chosen.InstallCmd != "" {
+		opts.Framework.InstallCmd = expandName(chosen.InstallCmd, opts)
+	}
+	if chosen.StartCmd != "" {
+		opts.Framework.StartCmd = expandName(chosen.StartCmd, opts)
+	}
+	if chosen.BuildCmd != "" {
+		opts.Framework.BuildCmd = expandName(chosen.BuildCmd, opts)
+	}
+	return applyPort(opts)
+}
+
+// expandName แทน __name__ ด้วยชื่อโปรเจ็กต์แบบ kebab-case
+func expandName(s string, opts ui.ProjectOptions) string {
+	return strings.ReplaceAll(s, "__name__", toKebab(opts.Name))
+}
+
+// goModule คืน Go module path ของโปรเจ็กต์ (ค่าเริ่มต้นคือชื่อโปรเจ็กต์)
+func goModule(opts ui.ProjectOptions) string {
+	if opts.GoModule != "" {
+		return opts.GoModule
+	}
+	return toKebab(opts.Name)
+}
+
+// goPackageName ชื่อ package ของ Go จากส่วนสุดท้ายของ module path (เช่น github.com/acme/str-utils -> strutils)
+// ส่วนที่เป็นเวอร์ชันหลัก (/v2) ถูกข้าม และชื่อที่ขึ้นต้นด้วยตัวเลขจะได้ prefix "lib"
+func goPackageName(opts ui.ProjectOptions) string {
+	parts := strings.Split(goModule(opts), "/")
+	last := parts[len(parts)-1]
+	if len(parts) > 1 && len(last) > 1 && last[0] == 'v' && strings.Trim(last[1:], "0123456789") == "" {
+		last = parts[len(parts)-2]
+	}
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(last))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "lib" + name
+	}
+	return name
+}
+
+// libraryDescription คำอธิบายของ library (ค่าเริ่มต้นเมื่อไม่ได้ระบุในวิซาร์ด เช่น สร้างจาก spec)
+func libraryDescription(opts ui.ProjectOptions) string {
+	if opts.Description != "" {
+		return opts.Description
+	}
+	return toKebab(opts.Name) + " library"
+}
@@ -0,0 +1,240 @@
+package generator
+
+// writer เป็นจุดเดียวที่ generator ใช้เขียนไฟล์ลงโปรเจ็กต์
+// เก็บรายการไฟล์ที่เขียนไว้เพื่อนำไปบันทึกใน manifest และจัดการไฟล์ที่ชนกับไฟล์เดิมในโหมด merge
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// ConflictPolicy วิธีจัดการเมื่อไฟล์ที่จะสร้างชนกับไฟล์ที่มีอยู่แล้วในโฟลเดอร์ปลายทาง
+type ConflictPolicy string
+
+const (
+	PolicySkip      ConflictPolicy = "skip"      // เก็บไฟล์เดิมไว้
+	PolicyOverwrite ConflictPolicy = "overwrite" // เขียนทับด้วยไฟล์จากเทมเพลต
+	PolicyKeepBoth  ConflictPolicy = "keep-both" // เก็บไฟล์เดิม และเขียนไฟล์ใหม่เป็น <name>.projgen<ext>
+	PolicyPrompt    ConflictPolicy = "prompt"    // ถามผู้ใช้ทีละไฟล์
+)
+
+// ConflictPolicies รายชื่อ policy ที่รองรับ
+var ConflictPolicies = []ConflictPolicy{PolicySkip, PolicyOverwrite, PolicyKeepBoth, PolicyPrompt}
+
+// ParseConflictPolicy แปลงข้อความเป็น ConflictPolicy
+func ParseConflictPolicy(s string) (ConflictPolicy, error) {
+	for _, p := range ConflictPolicies {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	names := make([]string, len(ConflictPolicies))
+	for i, p := range ConflictPolicies {
+		names[i] = string(p)
+	}
+	return "", fmt.Errorf("ไม่รู้จัก conflict policy %q (รองรับ: %s)", s, strings.Join(names, ", "))
+}
+
+// ConflictResolver ถามผู้ใช้ว่าจะจัดการไฟล์ที่ชนกันอย่างไร showDiff แสดง diff ระหว่างไฟล์เดิมกับไฟล์ใหม่
+// ต้องคืน skip, overwrite หรือ keep-both
+type ConflictResolver func(rel string, showDiff func()) (string, error)
+
+type conflict struct {
+	rel  string
+	data []byte
+}
+
+type writer struct {
+	root  string   // โฟลเดอร์ root ของโปรเจ็กต์
+	files []string // relative path (คั่นด้วย /) ของไฟล์ที่เขียนแล้ว ตามลำดับ
+	// prefix โฟลเดอร์ย่อยที่ writeFile, exists และ wrote ใช้เป็นฐานของ relative path (ดู inDir)
+	prefix string
+
+	// policy ว่างหมายถึงเขียนทับโดยไม่ตรวจ (ผู้เรียกตัดสินใจเรื่องไฟล์ชนกันเองแล้ว)
+	policy   ConflictPolicy
+	resolve  ConflictResolver
+	pending  []conflict        // ไฟล์ที่รอถามผู้ใช้ (PolicyPrompt)
+	skipped  []string          // ไฟล์เดิมที่ถูกเก็บไว้แทนไฟล์จากเทมเพลต
+	keptBoth map[string]string // ไฟล์เดิม -> ไฟล์ใหม่ที่เขียนไว้ข้าง ๆ
+
+	extras map[string][]string // extra -> ไฟล์ที่ extra เขียนในรอบนี้ (บันทึกลง manifest.Extras)
+}
+
+func newWriter(root string) *writer {
+	return &writer{root: root}
+}
+
+// inDir ให้ไฟล์ที่ fn เขียนลงไปอยู่ใต้โฟลเดอร์ย่อย dir เช่น apps/web ของ monorepo
+func (w *writer) inDir(dir string, fn func() error) error {
+	prev := w.prefix
+	w.prefix = path.Join(prev, dir)
+	defer func() { w.prefix = prev }()
+	return fn()
+}
+
+func (w *writer) full(rel string) string {
+	if w.prefix == "" {
+		return rel
+	}
+	return path.Join(w.prefix, rel)
+}
+
+// writeFile เขียนไฟล์ตาม relative path (คั่นด้วย /) พร้อมสร้างโฟลเดอร์ที่จำเป็น
+// ไฟล์ที่มีอยู่ก่อนแล้ว (ไม่ได้เขียนในรอบนี้) จะถูกจัดการตาม policy ของ writer
+func (w *writer) writeFile(rel string, data []byte) error {
+	rel = w.full(rel)
+	if w.policy != "" && !w.handled(rel) {
+		existing, err := os.ReadFile(filepath.Join(w.root, filepath.FromSlash(rel)))
+		switch {
+		case err == nil && bytes.Equal(existing, data):
+			// เนื้อหาเหมือนกัน ไม่ถือว่าชนกัน
+		case err == nil:
+			return w.handleConflict(w.policy, rel, data)
+		case !os.IsNotExist(err):
+			return err
+		}
+	}
+	return w.write(rel, data)
+}
+
+func (w *writer) write(rel string, data []byte) error {
+	dest := filepath.Join(w.root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(dest, data, 0o644); err != nil {
+		return err
+	}
+	w.track(rel)
+	return nil
+}
+
+func (w *writer) handleConflict(policy ConflictPolicy, rel string, data []byte) error {
+	switch policy {
+	case PolicyOverwrite:
+		return w.write(rel, data)
+	case PolicyKeepBoth:
+		alt := keepBothName(rel)
+		if w.keptBoth == nil {
+			w.keptBoth = map[string]string{}
+		}
+		w.keptBoth[rel] = alt
+		return w.write(alt, data)
+	case PolicyPrompt:
+		if w.resolve == nil {
+			w.skipped = append(w.skipped, rel)
+			return nil
+		}
+		// เลื่อนการถามไปหลังสร้างไฟล์อื่นเสร็จ เพื่อไม่ให้ชนกับสปินเนอร์
+		w.pending = append(w.pending, conflict{rel: rel, data: data})
+		return nil
+	default:
+		w.skipped = append(w.skipped, rel)
+		return nil
+	}
+}
+
+// resolvePending ถามผู้ใช้สำหรับไฟล์ที่ชนกันซึ่งรอไว้
+func (w *writer) resolvePending() error {
+	pending := w.pending
+	w.pending = nil
+	for _, c := range pending {
+		existing, err := os.ReadFile(filepath.Join(w.root, filepath.FromSlash(c.rel)))
+		if err != nil {
+			return err
+		}
+		showDiff := func() { printDiff(unifiedDiff(c.rel, existing, c.data)) }
+		choice, err := w.resolve(c.rel, showDiff)
+		if err != nil {
+			return err
+		}
+		policy, err := ParseConflictPolicy(choice)
+		if err != nil || policy == PolicyPrompt {
+			policy = PolicySkip
+		}
+		if err := w.handleConflict(policy, c.rel, c.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exists ตรวจว่ามีไฟล์ตาม relative path อยู่แล้วหรือไม่
+func (w *writer) exists(rel string) bool {
+	_, err := os.Stat(filepath.Join(w.root, filepath.FromSlash(w.full(rel))))
+	return err == nil
+}
+
+// read อ่านไฟล์ที่ writer เขียนเองในรอบนี้ ok=false ถ้าไม่ได้เขียน (รวมถึงไฟล์เดิมที่เก็บไว้ตอน merge)
+func (w *writer) read(rel string) ([]byte, bool) {
+	rel = w.full(rel)
+	if !contains(w.files, rel) {
+		return nil, false
+	}
+	b, err := os.ReadFile(filepath.Join(w.root, filepath.FromSlash(rel)))
+	return b, err == nil
+}
+
+// wrote ตรวจว่าไฟล์ถูกจัดการ (เขียน, รอถามผู้ใช้ หรือเก็บไฟล์เดิมไว้) ในรอบนี้แล้วหรือไม่
+func (w *writer) wrote(rel string) bool {
+	return w.handled(w.full(rel))
+}
+
+// handled เหมือน wrote แต่รับ path เทียบกับ root โดยไม่สน prefix
+func (w *writer) handled(rel string) bool {
+	for _, f := range w.files {
+		if f == rel {
+			return true
+		}
+	}
+	for _, c := range w.pending {
+		if c.rel == rel {
+			return true
+		}
+	}
+	if _, ok := w.keptBoth[rel]; ok {
+		return true
+	}
+	return contains(w.skipped, rel)
+}
+
+func (w *writer) track(rel string) {
+	for _, f := range w.files {
+		if f == rel {
+			return
+		}
+	}
+	w.files = append(w.files, rel)
+}
+
+// printConflicts สรุปไฟล์เดิมที่ถูกเก็บไว้ระหว่างการ merge
+func (w *writer) printConflicts() {
+	for _, rel := range w.skipped {
+		pterm.Info.Printfln("เก็บไฟล์เดิม %s ไว้ (ไม่ใช้ไฟล์จากเทมเพลต)", rel)
+	}
+	kept := make([]string, 0, len(w.keptBoth))
+	for rel := range w.keptBoth {
+		kept = append(kept, rel)
+	}
+	sort.Strings(kept)
+	for _, rel := range kept {
+		pterm.Info.Printfln("เก็บไฟล์เดิม %s และเขียนไฟล์จากเทมเพลตเป็น %s", rel, w.keptBoth[rel])
+	}
+}
+
+// keepBothName ตั้งชื่อไฟล์ใหม่สำหรับ keep-both เช่น README.md -> README.projgen.md, LICENSE -> LICENSE.projgen
+func keepBothName(rel string) string {
+	dir, base := path.Split(rel)
+	ext := path.Ext(base)
+	if ext == base { // dotfile เช่น .env
+		ext = ""
+	}
+	return dir + strings.TrimSuffix(base, ext) + ".projgen" + ext
+}
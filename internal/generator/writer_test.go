@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ไฟล์ที่ชนกับไฟล์เดิมต้องถูกจัดการตาม conflict policy รวมถึงคำตอบที่ถามผู้ใช้ภายหลัง (prompt)
+func TestWriterConflictPolicy(t *testing.T) {
+	const (
+		existing = "old\n"
+		incoming = "new\n"
+	)
+	tests := []struct {
+		name      string
+		policy    ConflictPolicy
+		answer    string // คำตอบของ resolver (ว่าง = ไม่มี resolver)
+		wantFile  string // เนื้อหา README.md หลังเขียน
+		wantAlt   bool   // มี README.projgen.md ที่มีเนื้อหาใหม่
+		wantTrack bool   // README.md อยู่ใน w.files
+		wantSkip  bool   // README.md อยู่ใน w.skipped
+	}{
+		{name: "skip", policy: PolicySkip, wantFile: existing, wantSkip: true},
+		{name: "overwrite", policy: PolicyOverwrite, wantFile: incoming, wantTrack: true},
+		{name: "keep-both", policy: PolicyKeepBoth, wantFile: existing, wantAlt: true},
+		{name: "prompt without resolver", policy: PolicyPrompt, wantFile: existing, wantSkip: true},
+		{name: "prompt skip", policy: PolicyPrompt, answer: "skip", wantFile: existing, wantSkip: true},
+		{name: "prompt overwrite", policy: PolicyPrompt, answer: "overwrite", wantFile: incoming, wantTrack: true},
+		{name: "prompt keep-both", policy: PolicyPrompt, answer: "keep-both", wantFile: existing, wantAlt: true},
+		{name: "prompt unknown answer", policy: PolicyPrompt, answer: "prompt", wantFile: existing, wantSkip: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			readme := filepath.Join(root, "README.md")
+			if err := os.WriteFile(readme, []byte(existing), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			w := newWriter(root)
+			w.policy = tt.policy
+			var asked []string
+			if tt.answer != "" {
+				w.resolve = func(rel string, showDiff func()) (string, error) {
+					asked = append(asked, rel)
+					return tt.answer, nil
+				}
+			}
+
+			if err := w.writeFile("README.md", []byte(incoming)); err != nil {
+				t.Fatal(err)
+			}
+			// ไฟล์ที่ไม่ชนกันเขียนได้ทันที แม้จะมีไฟล์รอถามผู้ใช้
+			if err := w.writeFile("main.go", []byte("package main\n")); err != nil {
+				t.Fatal(err)
+			}
+			if !w.wrote("README.md") {
+				t.Error("wrote(README.md) = false หลังจัดการไฟล์ที่ชนกัน")
+			}
+			if tt.answer != "" {
+				if len(w.pending) != 1 || len(asked) != 0 {
+					t.Fatalf("pending = %d, asked = %v: prompt ต้องรอถามหลังเขียนไฟล์อื่นเสร็จ", len(w.pending), asked)
+				}
+				if err := w.resolvePending(); err != nil {
+					t.Fatal(err)
+				}
+				if len(asked) != 1 || asked[0] != "README.md" {
+					t.Errorf("asked = %v, want [README.md]", asked)
+				}
+			}
+
+			b, err := os.ReadFile(readme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.wantFile {
+				t.Errorf("README.md = %q, want %q", b, tt.wantFile)
+			}
+			alt, err := os.ReadFile(filepath.Join(root, "README.projgen.md"))
+			if gotAlt := err == nil && string(alt) == incoming; gotAlt != tt.wantAlt {
+				t.Errorf("README.projgen.md มีเนื้อหาใหม่ = %v, want %v", gotAlt, tt.wantAlt)
+			}
+			if got := contains(w.files, "README.md"); got != tt.wantTrack {
+				t.Errorf("README.md ใน files = %v, want %v", got, tt.wantTrack)
+			}
+			if got := contains(w.skipped, "README.md"); got != tt.wantSkip {
+				t.Errorf("README.md ใน skipped = %v, want %v", got, tt.wantSkip)
+			}
+			if tt.wantAlt && w.keptBoth["README.md"] != "README.projgen.md" {
+				t.Errorf("keptBoth = %v", w.keptBoth)
+			}
+			if !contains(w.files, "main.go") {
+				t.Error("main.go ไม่อยู่ใน files")
+			}
+		})
+	}
+}
+
+// ไฟล์เดิมที่เนื้อหาเหมือนไฟล์ใหม่ไม่ถือว่าชนกัน
+func TestWriterIdenticalFile(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	w := newWriter(root)
+	w.policy = PolicySkip
+	if err := w.writeFile("go.mod", []byte("module x\n")); err != nil {
+		t.Fatal(err)
+	}
+	if !contains(w.files, "go.mod") || len(w.skipped) != 0 {
+		t.Errorf("files = %v, skipped = %v", w.files, w.skipped)
+	}
+}
+
+func TestKeepBothName(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"README.md", "README.projgen.md"},
+		{"LICENSE", "LICENSE.projgen"},
+		{".env", ".env.projgen"},
+		{"apps/web/vite.config.ts", "apps/web/vite.config.projgen.ts"},
+	}
+	for _, tt := range tests {
+		if got := keepBothName(tt.in); got != tt.want {
+			t.Errorf("keepBothName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package gitignore
+
+// ชิ้นส่วน (fragment) ของ .gitignore ที่ bundle ไว้ในไฟล์ executable (fragments/*.gitignore)
+// แยกตามรันไทม์ (node, go, python, rust, java), framework (next, nest, vite) และเครื่องมือ (env, ide, docker)
+// บรรทัดแรกของแต่ละ fragment เป็นหัวข้อ (# ...) ที่เหลือเป็น pattern
+
+import (
+	"embed"
+	"path"
+	"strings"
+)
+
+//go:embed fragments/*.gitignore
+var files embed.FS
+
+// fragments ชื่อ fragment -> บรรทัดทั้งหมดของไฟล์
+var fragments = map[string][]string{}
+
+func init() {
+	entries, _ := files.ReadDir("fragments")
+	for _, e := range entries {
+		b, err := files.ReadFile("fragments/" + e.Name())
+		if err != nil {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), path.Ext(e.Name()))
+		fragments[name] = strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+	}
+}
+
+// Compose ต่อ fragment ตามลำดับท้าย base (.gitignore เดิม เช่น ของเทมเพลต ว่างได้)
+// pattern ที่มีอยู่แล้ว (ตรงกันทุกตัวอักษร) ถูกตัดทิ้ง fragment ที่ไม่เหลือ pattern ใหม่จะไม่ถูกเพิ่ม
+// และชื่อที่ไม่รู้จักถูกข้าม
+func Compose(base string, names ...string) string {
+	seen := map[string]bool{}
+	for _, line := range strings.Split(base, "\n") {
+		if key := patternKey(line); key != "" {
+			seen[key] = true
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(base)
+	if base != "" && !strings.HasSuffix(base, "\n") {
+		sb.WriteString("\n")
+	}
+	for _, name := range names {
+		lines, ok := fragments[name]
+		if !ok || len(lines) == 0 {
+			continue
+		}
+		var added []string
+		for _, line := range lines[1:] {
+			if key := patternKey(line); key != "" && !seen[key] {
+				seen[key] = true
+				added = append(added, line)
+			}
+		}
+		if len(added) == 0 {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(lines[0] + "\n")
+		sb.WriteString(strings.Join(added, "\n") + "\n")
+	}
+	return sb.String()
+}
+
+// Strip ย้อนผลของ Compose: ตัดหัวข้อและ pattern ของ fragment ชื่อ names ที่ไม่มีอยู่ใน base (.gitignore ของเทมเพลต)
+// ออกจาก content พร้อมบรรทัดว่างที่คั่นหน้าหัวข้อ บรรทัดอื่น (เช่น pattern ที่ผู้ใช้เพิ่มเอง) คงไว้ตามเดิม
+// คืนสตริงว่างเมื่อไม่เหลือบรรทัดอื่น
+func Strip(content, base string, names ...string) string {
+	keep := map[string]bool{}
+	for _, line := range strings.Split(base, "\n") {
+		keep[strings.TrimSpace(line)] = true
+		if key := patternKey(line); key != "" {
+			keep[key] = true
+		}
+	}
+	headers := map[string]bool{}
+	added := map[string]bool{}
+	for _, name := range names {
+		lines := fragments[name]
+		if len(lines) == 0 {
+			continue
+		}
+		if !keep[lines[0]] {
+			headers[lines[0]] = true
+		}
+		for _, line := range lines[1:] {
+			if key := patternKey(line); key != "" && !keep[key] {
+				added[key] = true
+			}
+		}
+	}
+
+	var out []string
+	for _, line := range strings.Split(content, "\n") {
+		if headers[strings.TrimSpace(line)] {
+			if n := len(out); n > 0 && strings.TrimSpace(out[n-1]) == "" {
+				out = out[:n-1]
+			}
+			continue
+		}
+		if added[patternKey(line)] {
+			continue
+		}
+		out = append(out, line)
+	}
+	result := strings.Join(out, "\n")
+	if strings.TrimSpace(result) == "" {
+		return ""
+	}
+	return result
+}
+
+// patternKey คืน pattern สำหรับตรวจความซ้ำ (ว่างสำหรับบรรทัดว่างและ comment)
+// /dist, dist และ dist/ มีความหมายต่างกันใน gitignore จึงไม่ถือว่าซ้ำกัน
+func patternKey(line string) string {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ""
+	}
+	return line
+}
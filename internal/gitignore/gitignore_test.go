@@ -0,0 +1,88 @@
+package gitignore
+
+import "testing"
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  string
+		frags []string
+		want  string
+	}{
+		{
+			name:  "empty base",
+			frags: []string{"env", "docker"},
+			want: "# Environment\n.env\n.env.*\n!.env.example\n" +
+				"\n# Docker\ndocker-compose.override.yml\ncompose.override.yml\n",
+		},
+		{
+			name:  "skips patterns already in base",
+			base:  "node_modules\n.env\n",
+			frags: []string{"env"},
+			want:  "node_modules\n.env\n\n# Environment\n.env.*\n!.env.example\n",
+		},
+		{
+			name:  "anchored and directory patterns are distinct",
+			base:  "/.env\n.env.*/\n",
+			frags: []string{"env"},
+			want:  "/.env\n.env.*/\n\n# Environment\n.env\n.env.*\n!.env.example\n",
+		},
+		{
+			name:  "base without trailing newline",
+			base:  "secret.txt",
+			frags: []string{"docker"},
+			want:  "secret.txt\n\n# Docker\ndocker-compose.override.yml\ncompose.override.yml\n",
+		},
+		{
+			name:  "fragment fully covered is omitted",
+			base:  "docker-compose.override.yml\ncompose.override.yml\n",
+			frags: []string{"docker"},
+			want:  "docker-compose.override.yml\ncompose.override.yml\n",
+		},
+		{
+			name:  "negation differs from pattern",
+			base:  "!.env.example\n",
+			frags: []string{"env"},
+			want:  "!.env.example\n\n# Environment\n.env\n.env.*\n",
+		},
+		{
+			name:  "unknown and repeated names",
+			frags: []string{"cobol", "docker", "docker"},
+			want:  "# Docker\ndocker-compose.override.yml\ncompose.override.yml\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compose(tt.base, tt.frags...); got != tt.want {
+				t.Errorf("Compose() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Strip ต้องคืนเนื้อหาก่อน Compose โดยคงบรรทัดที่ผู้ใช้เพิ่มหลังจากนั้นไว้
+func TestStrip(t *testing.T) {
+	const tmpl = "# Logs\n*.log\nnode_modules\n"
+	frags := []string{"node", "env", "ide", "docker"}
+
+	tests := []struct {
+		name    string
+		base    string // .gitignore ของเทมเพลต
+		content string // ไฟล์ปัจจุบัน
+		want    string
+	}{
+		{"template only", tmpl, Compose(tmpl, frags...), tmpl},
+		{"no template", "", Compose("", frags...), ""},
+		{"user file", "", Compose("secret.txt\n", frags...), "secret.txt\n"},
+		{"user line after compose", tmpl, Compose(tmpl, frags...) + "secret.txt\n", tmpl + "secret.txt\n"},
+		{"user edited fragment", "", Compose("", "env") + "# mine\n", "# mine\n"},
+		{"nothing to strip", tmpl, tmpl, tmpl},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Strip(tt.content, tt.base, frags...); got != tt.want {
+				t.Errorf("Strip() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package license
+
+// ข้อความ license ที่ bundle ไว้ในไฟล์ executable (texts/*.txt) สำหรับสร้างไฟล์ LICENSE ของโปรเจ็กต์
+// ข้อความเป็น text/template ที่ใช้ {{.Year}} และ {{.Holder}} ในบรรทัด copyright
+
+import (
+	"bytes"
+	"embed"
+	"strings"
+	"text/template"
+)
+
+//go:embed texts/*.txt
+var texts embed.FS
+
+// Proprietary license แบบสงวนสิทธิ์ (ไม่ใช่ SPDX identifier) ไฟล์ LICENSE เป็นข้อความตั้งต้นให้แก้ตามสัญญาจริง
+const Proprietary = "proprietary"
+
+// License license ที่มีข้อความให้สร้างไฟล์ LICENSE
+type License struct {
+	ID   string // SPDX identifier หรือ Proprietary
+	Name string // ชื่อเต็มสำหรับแสดงในวิซาร์ด
+	file string // ไฟล์ใน texts/
+}
+
+var licenses = []License{
+	{ID: "MIT", Name: "MIT License", file: "MIT.txt"},
+	{ID: "Apache-2.0", Name: "Apache License 2.0", file: "Apache-2.0.txt"},
+	{ID: "BSD-3-Clause", Name: `BSD 3-Clause "New" or "Revised" License`, file: "BSD-3-Clause.txt"},
+	{ID: "GPL-3.0-only", Name: "GNU General Public License v3.0 only", file: "GPL-3.0.txt"},
+	{ID: "GPL-3.0-or-later", Name: "GNU General Public License v3.0 or later", file: "GPL-3.0.txt"},
+	{ID: Proprietary, Name: "Proprietary (สงวนสิทธิ์ทั้งหมด)", file: "proprietary.txt"},
+}
+
+// aliases ชื่อเดิมหรือชื่อย่อที่ผู้ใช้มักพิมพ์ -> identifier ที่ใช้จริง
+var aliases = map[string]string{
+	"apache2":             "Apache-2.0",
+	"bsd-3":               "BSD-3-Clause",
+	"gpl-3.0":             "GPL-3.0-only",
+	"gpl-3.0+":            "GPL-3.0-or-later",
+	"gpl3":                "GPL-3.0-only",
+	"gplv3":               "GPL-3.0-only",
+	"unlicensed":          Proprietary,
+	"all-rights-reserved": Proprietary,
+}
+
+// All คืน license ทั้งหมดที่มีข้อความ เรียงตามที่แสดงในวิซาร์ด
+func All() []License {
+	return append([]License(nil), licenses...)
+}
+
+// IDs คืน identifier ของทุก license ที่มีข้อความ
+func IDs() []string {
+	ids := make([]string, len(licenses))
+	for i, l := range licenses {
+		ids[i] = l.ID
+	}
+	return ids
+}
+
+// Normalize แปลงชื่อที่พิมพ์ (ไม่สนตัวพิมพ์เล็ก/ใหญ่ และชื่อเดิมเช่น GPL-3.0) เป็น identifier ของ license ที่รู้จัก
+// ชื่อที่ไม่รู้จักคืนค่าเดิม (เช่น ISC ยังใช้ใน metadata ได้แต่ไม่มีไฟล์ LICENSE ให้)
+func Normalize(id string) string {
+	id = strings.TrimSpace(id)
+	if l, ok := Find(id); ok {
+		return l.ID
+	}
+	return id
+}
+
+// Find ค้นหา license จาก identifier หรือชื่อเดิม
+func Find(id string) (License, bool) {
+	key := strings.ToLower(strings.TrimSpace(id))
+	if alias, ok := aliases[key]; ok {
+		key = strings.ToLower(alias)
+	}
+	for _, l := range licenses {
+		if strings.ToLower(l.ID) == key {
+			return l, true
+		}
+	}
+	return License{}, false
+}
+
+// Render คืนข้อความของไฟล์ LICENSE พร้อมปีและเจ้าของลิขสิทธิ์
+func (l License) Render(year int, holder string) ([]byte, error) {
+	b, err := texts.ReadFile("texts/" + l.file)
+	if err != nil {
+		return nil, err
+	}
+	t, err := template.New(l.file).Parse(string(b))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, struct {
+		Year   int
+		Holder string
+	}{year, holder})
+	return buf.Bytes(), err
+}
+
+// NPM ค่าของ license ใน package.json (npm ใช้ UNLICENSED สำหรับแพ็กเกจที่สงวนสิทธิ์)
+func (l License) NPM() string {
+	if l.ID == Proprietary {
+		return "UNLICENSED"
+	}
+	return l.ID
+}
+
+// SPDX ค่าของ license ในรูป SPDX expression สำหรับ pyproject.toml และ Cargo.toml
+func (l License) SPDX() string {
+	if l.ID == Proprietary {
+		return "LicenseRef-Proprietary"
+	}
+	return l.ID
+}
@@ -0,0 +1,76 @@
+package license
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		in     string
+		wantID string // ว่าง = ไม่รู้จัก
+	}{
+		{"MIT", "MIT"},
+		{"mit", "MIT"},
+		{"  Apache-2.0 ", "Apache-2.0"},
+		{"apache2", "Apache-2.0"},
+		{"BSD-3", "BSD-3-Clause"},
+		{"GPL-3.0", "GPL-3.0-only"},
+		{"gpl-3.0+", "GPL-3.0-or-later"},
+		{"GPLv3", "GPL-3.0-only"},
+		{"UNLICENSED", Proprietary},
+		{"all-rights-reserved", Proprietary},
+		{"Proprietary", Proprietary},
+		{"ISC", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		l, ok := Find(tt.in)
+		if ok != (tt.wantID != "") || l.ID != tt.wantID {
+			t.Errorf("Find(%q) = %q, %v, want %q", tt.in, l.ID, ok, tt.wantID)
+		}
+	}
+}
+
+// license ที่ไม่รู้จักต้องคงค่าเดิมไว้ใช้ใน metadata
+func TestNormalize(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"gplv3", "GPL-3.0-only"},
+		{" mit ", "MIT"},
+		{"ISC", "ISC"},
+		{" MPL-2.0 ", "MPL-2.0"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// ทุก license ต้องเรนเดอร์ได้ และใส่ปีกับเจ้าของลิขสิทธิ์ (ยกเว้น GPL ที่ใช้ข้อความต้นฉบับโดยไม่มีบรรทัด copyright)
+func TestRender(t *testing.T) {
+	for _, l := range All() {
+		b, err := l.Render(2026, "Acme Corp")
+		if err != nil {
+			t.Errorf("%s: %v", l.ID, err)
+			continue
+		}
+		if strings.HasPrefix(l.ID, "GPL-") {
+			continue
+		}
+		if !strings.Contains(string(b), "2026") || !strings.Contains(string(b), "Acme Corp") {
+			t.Errorf("%s: ไม่มีปีหรือเจ้าของลิขสิทธิ์ในข้อความ", l.ID)
+		}
+	}
+}
+
+func TestProprietaryIdentifiers(t *testing.T) {
+	l, _ := Find(Proprietary)
+	if l.NPM() != "UNLICENSED" || l.SPDX() != "LicenseRef-Proprietary" {
+		t.Errorf("NPM() = %q, SPDX() = %q", l.NPM(), l.SPDX())
+	}
+	mit, _ := Find("MIT")
+	if mit.NPM() != "MIT" || mit.SPDX() != "MIT" {
+		t.Errorf("MIT: NPM() = %q, SPDX() = %q", mit.NPM(), mit.SPDX())
+	}
+}
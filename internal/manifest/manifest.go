@@ -0,0 +1,126 @@
+package manifest
+
+// บันทึกการสร้างโปรเจ็กต์ (.projgen.json) ที่ projgen เขียนไว้ใน root ของทุกโปรเจ็กต์
+// ใช้เป็นข้อมูลสำหรับการอัปเดตเทมเพลต ตรวจสอบ drift และ audit ภายหลัง
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"projgen/internal/ui"
+)
+
+// FileName ชื่อไฟล์ manifest ใน root ของโปรเจ็กต์
+const FileName = ".projgen.json"
+
+// SchemaVersion เวอร์ชันของรูปแบบ manifest
+const SchemaVersion = 1
+
+// ErrNotFound คืนเมื่อโฟลเดอร์ไม่มีไฟล์ manifest
+var ErrNotFound = errors.New("ไม่พบไฟล์ " + FileName + " (โปรเจ็กต์นี้ไม่ได้สร้างด้วย projgen หรือสร้างก่อนมี manifest)")
+
+// Manifest ข้อมูลที่ใช้สร้างโปรเจ็กต์
+type Manifest struct {
+	SchemaVersion  int               `json:"schemaVersion"`
+	ProjgenVersion string            `json:"projgenVersion"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+	Template       Template          `json:"template"`
+	Options        ui.ProjectOptions `json:"options"`
+	Files          map[string]string `json:"files"` // relative path (คั่นด้วย /) -> sha256
+	// Installed hash หลังคำสั่งติดตั้ง (go mod tidy, npm install ฯลฯ) แก้ไฟล์ที่เรนเดอร์ไว้ เฉพาะไฟล์ที่ต่างจาก Files
+	Installed map[string]string `json:"installed,omitempty"`
+	// Extras ไฟล์ที่แต่ละ extra เขียน (ไม่รวมไฟล์ที่เทมเพลตมีอยู่แล้ว) projgen remove ลบเฉพาะไฟล์เหล่านี้
+	Extras map[string][]string `json:"extras,omitempty"`
+	// Apps เทมเพลตของแอปย่อยที่เรนเดอร์ร่วมกับเทมเพลตหลัก (แอปใน monorepo, frontend ฐานของ Tauri)
+	Apps []Template `json:"apps,omitempty"`
+}
+
+// Template ที่มาของเทมเพลตที่ใช้สร้าง
+type Template struct {
+	Path   string `json:"path"`             // path ในแค็ตตาล็อก เช่น templates/backend/go-fiber
+	Source string `json:"source,omitempty"` // โฟลเดอร์ที่ค้นพบจริงตอนสร้าง
+	Commit string `json:"commit,omitempty"` // git commit ล่าสุดที่แก้เทมเพลต (ถ้ามี)
+	Hash   string `json:"hash,omitempty"`   // hash ของเนื้อหาเทมเพลตทั้งหมด
+}
+
+// Path คืน path ของไฟล์ manifest ในโฟลเดอร์โปรเจ็กต์
+func Path(dir string) string {
+	return filepath.Join(dir, FileName)
+}
+
+// Read อ่าน manifest จากโฟลเดอร์โปรเจ็กต์
+func Read(dir string) (*Manifest, error) {
+	b, err := os.ReadFile(Path(dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("อ่าน %s ไม่สำเร็จ: %w", FileName, err)
+	}
+	if m.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("%s ใช้ schema เวอร์ชัน %d ซึ่งใหม่กว่าที่ projgen นี้รองรับ (%d)", FileName, m.SchemaVersion, SchemaVersion)
+	}
+	if m.Files == nil {
+		m.Files = map[string]string{}
+	}
+	return &m, nil
+}
+
+// Write บันทึก manifest ลงโฟลเดอร์โปรเจ็กต์
+func Write(dir string, m *Manifest) error {
+	m.SchemaVersion = SchemaVersion
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(Path(dir), append(b, '\n'), 0o644)
+}
+
+// HashBytes คืน hash ของเนื้อหาในรูปแบบ sha256:<hex>
+func HashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// HashFile คืน hash ของไฟล์ในรูปแบบเดียวกับ HashBytes
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// HashFiles คำนวณ hash ของไฟล์ตามรายการ relative path ภายใต้ root
+// ไฟล์ที่หายไปแล้วจะถูกข้าม
+func HashFiles(root string, rels []string) (map[string]string, error) {
+	out := make(map[string]string, len(rels))
+	for _, rel := range rels {
+		h, err := HashFile(filepath.Join(root, filepath.FromSlash(rel)))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[rel] = h
+	}
+	return out, nil
+}
@@ -0,0 +1,266 @@
+package naming
+
+// ตรวจความถูกต้องของชื่อโปรเจ็กต์ตามกติกาของแต่ละ ecosystem (npm, Go module, Cargo, Docker, ระบบไฟล์)
+// และเสนอชื่อที่แก้ไขแล้วเมื่อชื่อไม่ผ่าน
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Ecosystem ระบบที่ชื่อโปรเจ็กต์ต้องใช้ได้
+type Ecosystem string
+
+const (
+	NPM        Ecosystem = "npm"
+	GoModule   Ecosystem = "go module"
+	Cargo      Ecosystem = "cargo"
+	Docker     Ecosystem = "docker"
+	Filesystem Ecosystem = "filesystem"
+)
+
+// Error ชื่อไม่ผ่านกติกาของ ecosystem พร้อมชื่อที่แนะนำ
+type Error struct {
+	Ecosystem  Ecosystem
+	Name       string
+	Reason     string
+	Suggestion string
+}
+
+var labels = map[Ecosystem]string{
+	NPM:        "ชื่อ npm package",
+	GoModule:   "Go module path",
+	Cargo:      "ชื่อ Cargo package",
+	Docker:     "ชื่อ Docker image",
+	Filesystem: "ชื่อโฟลเดอร์",
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("ชื่อ %q ใช้เป็น%s ไม่ได้: %s", e.Name, labels[e.Ecosystem], e.Reason)
+	if e.Suggestion != "" && e.Suggestion != e.Name {
+		msg += fmt.Sprintf(" — ลองใช้ %q", e.Suggestion)
+	}
+	return msg
+}
+
+// EcosystemsFor คืน ecosystem ที่ชื่อโปรเจ็กต์ต้องผ่านตามรันไทม์/ภาษา
+// ทุกโปรเจ็กต์ต้องเป็นชื่อโฟลเดอร์และชื่อ Docker image ได้
+func EcosystemsFor(runtime, language string) []Ecosystem {
+	ecos := []Ecosystem{Filesystem, Docker}
+	switch {
+	case strings.EqualFold(runtime, "go") || strings.EqualFold(language, "Go"):
+		ecos = append(ecos, GoModule)
+	case strings.EqualFold(runtime, "rust") || strings.EqualFold(language, "Rust"):
+		ecos = append(ecos, Cargo)
+	case strings.EqualFold(language, "Python") || strings.EqualFold(language, "Java"):
+		// ชื่อ distribution ใน pyproject.toml และ artifactId ของ Gradle/Maven ใช้ชื่อแบบ kebab-case ได้อยู่แล้ว
+	case runtime == "node" || runtime == "bun" || runtime == "deno":
+		ecos = append(ecos, NPM)
+	}
+	return ecos
+}
+
+// Validate ตรวจชื่อกับทุก ecosystem ที่ระบุ และคืน error แรกที่พบ
+func Validate(name string, ecosystems ...Ecosystem) error {
+	for _, eco := range ecosystems {
+		var reason string
+		switch eco {
+		case NPM:
+			reason = npmReason(name)
+		case GoModule:
+			reason = goModuleReason(name)
+		case Cargo:
+			reason = cargoReason(name)
+		case Docker:
+			reason = dockerReason(name)
+		case Filesystem:
+			reason = filesystemReason(name)
+		}
+		if reason != "" {
+			return &Error{Ecosystem: eco, Name: name, Reason: reason, Suggestion: Suggest(name)}
+		}
+	}
+	return nil
+}
+
+// ValidateNPM ตรวจชื่อ package ตามกติกาของ npm สำหรับ package ใหม่
+func ValidateNPM(name string) error { return Validate(name, NPM) }
+
+// ValidateGoModule ตรวจ module path ตามกติกาของ go mod
+func ValidateGoModule(path string) error { return Validate(path, GoModule) }
+
+// ValidateDocker ตรวจชื่อ image repository ตามกติกาของ Docker
+func ValidateDocker(name string) error { return Validate(name, Docker) }
+
+// ValidateFilesystem ตรวจว่าชื่อใช้เป็นชื่อโฟลเดอร์ได้บนทุกระบบปฏิบัติการ
+func ValidateFilesystem(name string) error { return Validate(name, Filesystem) }
+
+// Suggest แปลงชื่อให้ผ่านทุก ecosystem: ตัวพิมพ์เล็ก a-z, 0-9 คั่นด้วย "-"
+// อักขระที่ไม่ใช่ ASCII (เช่น ภาษาไทย) จะถูกตัดออก หากไม่เหลืออะไรจะคืน "my-app"
+// ชื่อที่ขึ้นต้นด้วยตัวเลขจะเติม "app-" ข้างหน้า ส่วนชื่อสงวน (npm, Windows, keyword ของ Rust) จะเติม "-app" ต่อท้าย
+func Suggest(name string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	s := sb.String()
+	if s == "" {
+		return "my-app"
+	}
+	// ชื่อ crate ของ Cargo ต้องขึ้นต้นด้วยตัวอักษร
+	if s[0] >= '0' && s[0] <= '9' {
+		s = "app-" + s
+	}
+	if len(s) > 64 {
+		s = strings.TrimRight(s[:64], "-")
+	}
+	if npmBuiltins[s] || windowsReserved[s] || rustKeywords[s] || s == "node_modules" {
+		s += "-app"
+	}
+	return s
+}
+
+var (
+	npmUnsafe       = regexp.MustCompile(`[^a-z0-9._~-]`)
+	dockerRepo      = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	goPathElem      = regexp.MustCompile(`^[A-Za-z0-9._~-]+$`)
+	cargoName       = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+	fsForbidden     = `<>:"/\|?*`
+	npmBuiltins     = setOf("assert", "buffer", "child_process", "cluster", "console", "constants", "crypto", "dgram", "dns", "domain", "events", "fs", "http", "http2", "https", "module", "net", "os", "path", "process", "punycode", "querystring", "readline", "repl", "stream", "string_decoder", "sys", "timers", "tls", "tty", "url", "util", "v8", "vm", "worker_threads", "zlib")
+	rustKeywords    = setOf("as", "async", "await", "break", "const", "continue", "crate", "dyn", "else", "enum", "extern", "false", "fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "move", "mut", "pub", "ref", "return", "self", "static", "struct", "super", "trait", "true", "type", "unsafe", "use", "where", "while", "test")
+	windowsReserved = setOf("con", "prn", "aux", "nul",
+		"com1", "com2", "com3", "com4", "com5", "com6", "com7", "com8", "com9",
+		"lpt1", "lpt2", "lpt3", "lpt4", "lpt5", "lpt6", "lpt7", "lpt8", "lpt9")
+)
+
+func npmReason(name string) string {
+	switch {
+	case name == "":
+		return "ต้องไม่เป็นค่าว่าง"
+	case len(name) > 214:
+		return "ยาวเกิน 214 ตัวอักษร"
+	case strings.TrimSpace(name) != name:
+		return "ต้องไม่มีช่องว่างนำหน้าหรือต่อท้าย"
+	case name == "node_modules" || name == "favicon.ico":
+		return "เป็นชื่อที่ npm สงวนไว้"
+	case npmBuiltins[name]:
+		return "ซ้ำกับ core module ของ Node.js"
+	case strings.ToLower(name) != name:
+		return "ต้องเป็นตัวพิมพ์เล็กทั้งหมด"
+	}
+	// scoped package: @scope/name
+	if strings.HasPrefix(name, "@") {
+		scope, pkg, ok := strings.Cut(name[1:], "/")
+		if !ok || scope == "" || pkg == "" || strings.Contains(pkg, "/") {
+			return "scoped package ต้องอยู่ในรูป @scope/name"
+		}
+		if r := npmPartReason(scope); r != "" {
+			return r
+		}
+		return npmPartReason(pkg)
+	}
+	return npmPartReason(name)
+}
+
+func npmPartReason(part string) string {
+	switch {
+	case strings.HasPrefix(part, ".") || strings.HasPrefix(part, "_"):
+		return "ต้องไม่ขึ้นต้นด้วย . หรือ _"
+	case npmUnsafe.MatchString(part):
+		return "ใช้ได้เฉพาะ a-z, 0-9, -, ., _ และ ~"
+	}
+	return ""
+}
+
+func goModuleReason(path string) string {
+	if path == "" {
+		return "ต้องไม่เป็นค่าว่าง"
+	}
+	if strings.HasPrefix(path, "-") {
+		return "ต้องไม่ขึ้นต้นด้วย -"
+	}
+	for _, elem := range strings.Split(path, "/") {
+		switch {
+		case elem == "":
+			return "มี path element ว่าง (// หรือ / ที่หัวหรือท้าย)"
+		case !goPathElem.MatchString(elem):
+			return fmt.Sprintf("%q ใช้ได้เฉพาะ A-Z, a-z, 0-9, -, ., _ และ ~", elem)
+		case strings.HasPrefix(elem, ".") || strings.HasSuffix(elem, "."):
+			return fmt.Sprintf("%q ต้องไม่ขึ้นต้นหรือลงท้ายด้วย .", elem)
+		case windowsReserved[strings.ToLower(strings.SplitN(elem, ".", 2)[0])]:
+			return fmt.Sprintf("%q เป็นชื่อที่ Windows สงวนไว้", elem)
+		}
+	}
+	return ""
+}
+
+// cargoReason ตรวจชื่อ package ตามกติกาของ cargo new
+func cargoReason(name string) string {
+	switch {
+	case name == "":
+		return "ต้องไม่เป็นค่าว่าง"
+	case len(name) > 64:
+		return "ยาวเกิน 64 ตัวอักษร"
+	case !cargoName.MatchString(name):
+		return "ใช้ได้เฉพาะ a-z, A-Z, 0-9, - และ _ และต้องขึ้นต้นด้วยตัวอักษร"
+	case rustKeywords[name]:
+		return "ซ้ำกับ keyword ของ Rust"
+	}
+	return ""
+}
+
+func dockerReason(name string) string {
+	switch {
+	case name == "":
+		return "ต้องไม่เป็นค่าว่าง"
+	case len(name) > 255:
+		return "ยาวเกิน 255 ตัวอักษร"
+	case strings.ToLower(name) != name:
+		return "ต้องเป็นตัวพิมพ์เล็กทั้งหมด"
+	case !dockerRepo.MatchString(name):
+		return "ใช้ได้เฉพาะ a-z, 0-9 คั่นด้วย ., _, __ หรือ - และต้องขึ้นต้น/ลงท้ายด้วยตัวอักษรหรือตัวเลข"
+	}
+	return ""
+}
+
+func filesystemReason(name string) string {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return "ต้องไม่เป็นค่าว่าง"
+	case name == "." || name == "..":
+		return "ต้องไม่เป็น . หรือ .."
+	case len(name) > 255:
+		return "ยาวเกิน 255 ไบต์"
+	case strings.ContainsAny(name, fsForbidden):
+		return "ต้องไม่มีอักขระ " + fsForbidden
+	case strings.HasSuffix(name, ".") || strings.HasSuffix(name, " "):
+		return "ต้องไม่ลงท้ายด้วย . หรือช่องว่าง (ใช้บน Windows ไม่ได้)"
+	case windowsReserved[strings.ToLower(strings.SplitN(name, ".", 2)[0])]:
+		return "เป็นชื่อที่ Windows สงวนไว้"
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return "ต้องไม่มี control character"
+		}
+	}
+	return ""
+}
+
+func setOf(items ...string) map[string]bool {
+	m := make(map[string]bool, len(items))
+	for _, s := range items {
+		m[s] = true
+	}
+	return m
+}
@@ -0,0 +1,129 @@
+package naming
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		eco     Ecosystem
+		wantErr bool
+	}{
+		{"my-app", NPM, false},
+		{"@acme/my-app", NPM, false},
+		{"my.app_v2~x", NPM, false},
+		{"", NPM, true},
+		{"MyApp", NPM, true},
+		{"fs", NPM, true},
+		{"node_modules", NPM, true},
+		{"_private", NPM, true},
+		{".hidden", NPM, true},
+		{"my app", NPM, true},
+		{"@acme", NPM, true},
+		{"@acme/a/b", NPM, true},
+		{strings.Repeat("a", 215), NPM, true},
+
+		{"github.com/acme/my-app", GoModule, false},
+		{"example.com/MyApp/v2", GoModule, false},
+		{"", GoModule, true},
+		{"-app", GoModule, true},
+		{"github.com//app", GoModule, true},
+		{"github.com/acme/", GoModule, true},
+		{"github.com/acme/my app", GoModule, true},
+		{"github.com/acme/app.", GoModule, true},
+		{"example.com/con", GoModule, true},
+
+		{"my_crate", Cargo, false},
+		{"my-crate2", Cargo, false},
+		{"2crate", Cargo, true},
+		{"fn", Cargo, true},
+		{"my.crate", Cargo, true},
+		{strings.Repeat("a", 65), Cargo, true},
+
+		{"my-app", Docker, false},
+		{"acme/my_app.v2", Docker, false},
+		{"my__app", Docker, false},
+		{"MyApp", Docker, true},
+		{"-app", Docker, true},
+		{"app-", Docker, true},
+		{"my___app", Docker, true},
+
+		{"แอปของฉัน", Filesystem, false},
+		{"my app", Filesystem, false},
+		{"", Filesystem, true},
+		{"..", Filesystem, true},
+		{"a/b", Filesystem, true},
+		{"what?", Filesystem, true},
+		{"app.", Filesystem, true},
+		{"CON", Filesystem, true},
+		{"nul.txt", Filesystem, true},
+		{"tab\there", Filesystem, true},
+	}
+	for _, tt := range tests {
+		err := Validate(tt.name, tt.eco)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q, %s) error = %v, wantErr %v", tt.name, tt.eco, err, tt.wantErr)
+			continue
+		}
+		if err == nil {
+			continue
+		}
+		var nerr *Error
+		if !errors.As(err, &nerr) {
+			t.Errorf("Validate(%q, %s) คืน %T ไม่ใช่ *Error", tt.name, tt.eco, err)
+			continue
+		}
+		if nerr.Ecosystem != tt.eco || nerr.Reason == "" {
+			t.Errorf("Validate(%q, %s) = %+v", tt.name, tt.eco, nerr)
+		}
+	}
+}
+
+// ตรวจหลาย ecosystem ต้องคืน error ของ ecosystem แรกที่ไม่ผ่าน
+func TestValidateOrder(t *testing.T) {
+	err := Validate("My App", Filesystem, Docker, NPM)
+	var nerr *Error
+	if !errors.As(err, &nerr) {
+		t.Fatalf("Validate คืน %v", err)
+	}
+	if nerr.Ecosystem != Docker {
+		t.Errorf("ecosystem = %s, want %s", nerr.Ecosystem, Docker)
+	}
+	if nerr.Suggestion != "my-app" {
+		t.Errorf("suggestion = %q, want %q", nerr.Suggestion, "my-app")
+	}
+}
+
+// ชื่อที่ Suggest คืนต้องผ่านทุก ecosystem
+func TestSuggest(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"My App", "my-app"},
+		{"  --hello__world--  ", "hello-world"},
+		{"แอปของฉัน", "my-app"},
+		{"แอป v2", "v2"},
+		{"fs", "fs-app"},
+		{"CON", "con-app"},
+		{"node_modules", "node-modules"},
+		{"123app", "app-123app"},
+		{"2 Fast", "app-2-fast"},
+		{"self", "self-app"},
+		{"fn", "fn-app"},
+		{"test", "test-app"},
+		{"module", "module-app"},
+		{"9" + strings.Repeat("x", 70), "app-9" + strings.Repeat("x", 59)},
+		{strings.Repeat("ab-", 30), strings.TrimRight(strings.Repeat("ab-", 22)[:64], "-")},
+	}
+	all := []Ecosystem{NPM, GoModule, Cargo, Docker, Filesystem}
+	for _, tt := range tests {
+		got := Suggest(tt.in)
+		if got != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+		if err := Validate(got, all...); err != nil {
+			t.Errorf("Suggest(%q) = %q ไม่ผ่าน: %v", tt.in, got, err)
+		}
+	}
+}
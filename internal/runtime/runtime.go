@@ -1,14 +1,16 @@
 package runtime
 
-// เครื่องมือตรวจจับสภาพแวดล้อมรันไทม์ (Node/Bun/Deno/Go/Python/npm/pip) และตรวจสอบเวอร์ชัน
+// เครื่องมือตรวจจับสภาพแวดล้อมรันไทม์ (Node/Bun/Deno/Go/Python/Rust/Java/npm/pip/cargo/mvn/gradle) และตรวจสอบเวอร์ชัน
 // รวมถึงยูทิลิตี้สำหรับเรียกคำสั่งแบบข้ามแพลตฟอร์ม พร้อมข้อความเตือนแบบมีสีสัน
 
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"os/exec"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/pterm/pterm"
@@ -16,13 +18,13 @@ import (
 
 // RuntimeStatus สถานะของรันไทม์ที่ตรวจพบ
 type RuntimeStatus struct {
-	Name    string // ชื่อรันไทม์ เช่น node, npm, go, python, bun, deno, pip
+	Name    string // ชื่อรันไทม์ เช่น node, npm, go, python, bun, deno, pip, cargo, rustc
 	Found   bool   // พบหรือไม่
 	Version string // เวอร์ชันที่ตรวจพบ (เช่น 20.11.1)
 }
 
 // Detect ค้นหารันไทม์ที่ใช้งานได้จากเครื่อง โดยเรียงลำดับความนิยม
-// คืนค่าเป็นชื่อรันไทม์เช่น "node", "bun", "deno", "go" หรือ "unknown"
+// คืนค่าเป็นชื่อรันไทม์เช่น "node", "bun", "deno", "go", "rust", "java" หรือ "unknown"
 func Detect(ctx context.Context) string {
 	// ลองตรวจจากคำสั่งที่นิยมใช้ในการพัฒนาเว็บ/แอปก่อน
 	if has("node") {
@@ -37,12 +39,18 @@ func Detect(ctx context.Context) string {
 	if has("go") {
 		return "go"
 	}
+	if has("cargo") {
+		return "rust"
+	}
+	if has("java") {
+		return "java"
+	}
 	return "unknown"
 }
 
 // InspectAll ตรวจสอบรันไทม์ยอดนิยมและคืนผลลัพธ์ทั้งหมด
 func InspectAll(ctx context.Context) []RuntimeStatus {
-	names := []string{"node", "npm", "go", "python", "pip", "bun", "deno"}
+	names := []string{"node", "npm", "go", "python", "pip", "bun", "deno", "cargo", "rustc", "java", "mvn", "gradle"}
 	out := make([]RuntimeStatus, 0, len(names))
 	for _, n := range names {
 		out = append(out, CheckRuntime(ctx, n))
@@ -72,6 +80,58 @@ func CheckRuntime(ctx context.Context, name string) RuntimeStatus {
 	return RuntimeStatus{Name: name, Found: false}
 }
 
+// Require หาไบนารีของรันไทม์ที่มีเวอร์ชันไม่ต่ำกว่า min (เช่น python >= 3.10, min ว่าง = ขอแค่มี) โดยลองทุกชื่อที่เป็นไปได้
+// (python อาจเป็น Python 2 ขณะที่ python3 เป็นเวอร์ชันใหม่) คืนชื่อไบนารีและเวอร์ชันที่พบ
+func Require(ctx context.Context, name, min string) (bin, version string, err error) {
+	candidates, args := commandFor(name)
+	if candidates == "" {
+		return "", "", fmt.Errorf("ไม่รู้จักรันไทม์ %s", name)
+	}
+	var older []string
+	for _, candidate := range strings.Split(candidates, "|") {
+		candidate = strings.TrimSpace(candidate)
+		if !has(candidate) {
+			continue
+		}
+		ver := runVersion(ctx, candidate, args)
+		if min == "" || (ver != "" && AtLeast(ver, min)) {
+			return candidate, ver, nil
+		}
+		if ver != "" {
+			older = append(older, fmt.Sprintf("%s %s", candidate, ver))
+		}
+	}
+	if len(older) > 0 {
+		return "", "", fmt.Errorf("ต้องใช้ %s เวอร์ชัน %s ขึ้นไป แต่พบ %s", name, min, strings.Join(older, ", "))
+	}
+	if min == "" {
+		return "", "", fmt.Errorf("ไม่พบ %s บนเครื่องนี้", name)
+	}
+	return "", "", fmt.Errorf("ไม่พบ %s เวอร์ชัน %s ขึ้นไปบนเครื่องนี้", name, min)
+}
+
+// AtLeast ตรวจว่า version (เช่น 3.12.1) ไม่ต่ำกว่า min (เช่น 3.10) โดยเทียบทีละส่วนเป็นตัวเลข
+func AtLeast(version, min string) bool {
+	v := strings.Split(version, ".")
+	m := strings.Split(min, ".")
+	for i := range m {
+		mi, _ := strconv.Atoi(m[i])
+		vi := 0
+		if i < len(v) {
+			vi, _ = strconv.Atoi(v[i])
+		}
+		if vi != mi {
+			return vi > mi
+		}
+	}
+	return true
+}
+
+// InstallTips คำแนะนำการติดตั้งรันไทม์สำหรับระบบปฏิบัติการปัจจุบัน
+func InstallTips(name string) []string {
+	return suggestInstall(name)
+}
+
 // PrintReport แสดงผลสรุปการตรวจสอบด้วยสี และแนะนำวิธีติดตั้งเมื่อไม่พบ
 func PrintReport(statuses []RuntimeStatus) {
 	// แสดงตารางย่อ
@@ -111,6 +171,18 @@ func commandFor(name string) (bin string, args []string) {
 		return "bun", []string{"--version"}
 	case "deno":
 		return "deno", []string{"--version"}
+	case "cargo":
+		return "cargo", []string{"--version"}
+	case "java":
+		// java -version เขียนลง stderr และใช้ได้ทั้ง JDK 8 และรุ่นใหม่
+		return "java", []string{"-version"}
+	case "mvn":
+		return "mvn", []string{"--version"}
+	case "gradle":
+		return "gradle", []string{"--version"}
+	case "rustc", "rust":
+		// เวอร์ชันของรันไทม์ rust คือเวอร์ชันของคอมไพเลอร์
+		return "rustc", []string{"--version"}
 	default:
 		return "", nil
 	}
@@ -131,11 +203,17 @@ func runVersion(ctx context.Context, bin string, args []string) string {
 	return parseVersion(out)
 }
 
-var verRe = regexp.MustCompile(`\d+\.\d+(\.\d+)?`)
+// verRe เลขเวอร์ชันตัวแรกในข้อความ ยอมรับเลข major อย่างเดียว (เช่น Java 21 ไม่มี .0)
+var verRe = regexp.MustCompile(`\d+(\.\d+){0,2}`)
+
+// quotedVerRe เวอร์ชันในเครื่องหมายคำพูดของ java -version เช่น openjdk version "21" หรือ java version "1.8.0_392"
+var quotedVerRe = regexp.MustCompile(`version "(\d+(\.\d+){0,2})`)
 
 func parseVersion(s string) string {
-	m := verRe.FindString(s)
-	return m
+	if m := quotedVerRe.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+	return verRe.FindString(s)
 }
 
 func suggestInstall(name string) []string {
@@ -188,6 +266,41 @@ func suggestInstall(name string) []string {
 		} else {
 			tips = append(tips, "curl -fsSL https://deno.land/x/install/install.sh | sh (โปรดตรวจสอบสคริปต์ก่อนรัน)")
 		}
+	case "java":
+		if os == "windows" {
+			tips = append(tips,
+				"winget install EclipseAdoptium.Temurin.21.JDK",
+				"หรือดาวน์โหลดจาก https://adoptium.net/")
+		} else {
+			tips = append(tips,
+				"sdk install java 21-tem (SDKMAN!) หรือแพ็กเกจเมเนเจอร์ของระบบ",
+				"ดูเพิ่มเติม: https://adoptium.net/")
+		}
+	case "mvn":
+		if os == "windows" {
+			tips = append(tips, "winget install Apache.Maven หรือ choco install maven")
+		} else {
+			tips = append(tips, "sdk install maven (SDKMAN!) หรือแพ็กเกจเมเนเจอร์ของระบบ")
+		}
+		tips = append(tips, "ดูเพิ่มเติม: https://maven.apache.org/install.html")
+	case "gradle":
+		if os == "windows" {
+			tips = append(tips, "winget install Gradle.Gradle หรือ choco install gradle")
+		} else {
+			tips = append(tips, "sdk install gradle (SDKMAN!) หรือแพ็กเกจเมเนเจอร์ของระบบ")
+		}
+		tips = append(tips, "ดูเพิ่มเติม: https://gradle.org/install/")
+	case "cargo", "rustc", "rust":
+		if os == "windows" {
+			tips = append(tips,
+				"winget install Rustlang.Rustup",
+				"หรือดาวน์โหลด rustup-init.exe จาก https://rustup.rs/")
+		} else {
+			tips = append(tips,
+				"curl --proto '=https' --tlsv1.2 -sSf https://sh.rustup.rs | sh (โปรดตรวจสอบสคริปต์ก่อนรัน)",
+				"ดูเพิ่มเติม: https://www.rust-lang.org/tools/install")
+		}
+		tips = append(tips, "rustup ติดตั้งทั้ง rustc และ cargo — อัปเดตด้วย rustup update")
 	default:
 		tips = append(tips, "ค้นหาวิธีติดตั้งจากเอกสารทางการ")
 	}
@@ -0,0 +1,27 @@
+package runtime
+
+import "testing"
+
+// ตัวอย่างผลลัพธ์จริงของคำสั่งเวอร์ชันแต่ละตัว
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name, out, want string
+	}{
+		{"java 21", "openjdk version \"21\" 2023-09-19\nOpenJDK Runtime Environment (build 21+35-2513)\nOpenJDK 64-Bit Server VM (build 21+35-2513, mixed mode, sharing)", "21"},
+		{"java 17", "openjdk version \"17.0.12\" 2024-07-16\nOpenJDK Runtime Environment Temurin-17.0.12+7 (build 17.0.12+7)", "17.0.12"},
+		{"java 8", "java version \"1.8.0_392\"\nJava(TM) SE Runtime Environment (build 1.8.0_392-b08)", "1.8.0"},
+		{"mvn", "Apache Maven 3.9.6 (bc0240f3c744dd6b6ec2920b3cd08dcc295161ae)\nMaven home: /usr/share/maven\nJava version: 21.0.4, vendor: Eclipse Adoptium", "3.9.6"},
+		{"gradle", "\n------------------------------------------------------------\nGradle 8.10.2\n------------------------------------------------------------\n\nBuild time:    2024-09-23 21:28:39 UTC\nKotlin:        1.9.24", "8.10.2"},
+		{"go", "go version go1.22.1 linux/amd64", "1.22.1"},
+		{"go release candidate", "go version go1.23rc1 darwin/arm64", "1.23"},
+		{"rustc", "rustc 1.90.0 (1159e78c4 2025-09-14)", "1.90.0"},
+		{"node", "v20.11.1", "20.11.1"},
+		{"pip", "pip 24.0 from /usr/lib/python3/dist-packages/pip (python 3.12)", "24.0"},
+		{"no version", "command not found", ""},
+	}
+	for _, tt := range tests {
+		if got := parseVersion(tt.out); got != tt.want {
+			t.Errorf("%s: parseVersion() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
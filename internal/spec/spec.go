@@ -0,0 +1,179 @@
+package spec
+
+// ไฟล์ spec (YAML) สำหรับสร้างหลาย service ในครั้งเดียว ใช้กับ projgen create --spec services.yaml
+//
+//	name: shop-platform
+//	layout: repo            # repo = services/<name> ใน repository เดียว, siblings = โฟลเดอร์พี่น้องกัน
+//	install: false          # ติดตั้ง dependencies ของทุก service หลังสร้าง
+//	services:
+//	  - name: gateway
+//	    framework: go-gin
+//	    port: 8080            # ไม่ระบุ = พอร์ตเริ่มต้นของ framework เลื่อนไปจนไม่ชนกับ service อื่น
+//	    extras: [dockerfile, env]
+//	  - name: orders
+//	    framework: fastapi-api
+//	    depends_on: [gateway]
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"projgen/internal/config"
+	"projgen/internal/naming"
+)
+
+// Layout รูปแบบการวางโฟลเดอร์ของ service
+type Layout string
+
+const (
+	// LayoutRepo ทุก service อยู่ใต้ services/ ของ repository เดียว
+	LayoutRepo Layout = "repo"
+	// LayoutSiblings แต่ละ service เป็นโฟลเดอร์ของตัวเองข้างกัน
+	LayoutSiblings Layout = "siblings"
+)
+
+// Spec รายการ service ที่จะสร้าง
+type Spec struct {
+	Name     string    `yaml:"name"`
+	Layout   Layout    `yaml:"layout"`
+	Install  bool      `yaml:"install"`
+	Services []Service `yaml:"services"`
+}
+
+// Service ตัวเลือกของแต่ละ service (framework ใช้ Name จากแค็ตตาล็อก)
+type Service struct {
+	Name      string   `yaml:"name"`
+	Framework string   `yaml:"framework"`
+	Variant   string   `yaml:"variant"`
+	Port      int      `yaml:"port"`
+	Module    string   `yaml:"module"`
+	Extras    []string `yaml:"extras"`
+	DependsOn []string `yaml:"depends_on"`
+}
+
+// Load อ่านและตรวจสอบไฟล์ spec
+func Load(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(b)
+}
+
+// Parse แปลง YAML เป็น Spec ใส่ค่าเริ่มต้น และตรวจสอบความถูกต้อง
+func Parse(b []byte) (*Spec, error) {
+	var s Spec
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("อ่านไฟล์ spec ไม่สำเร็จ: %w", err)
+	}
+	if s.Layout == "" {
+		s.Layout = LayoutRepo
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate ตรวจชื่อ framework, extras, variant, พอร์ต และ depends_on ของทุก service
+// รวบรวมทุกปัญหาที่พบไว้ใน error เดียว เพื่อแก้ไฟล์ได้ในรอบเดียว
+func (s *Spec) Validate() error {
+	var problems []string
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	switch s.Layout {
+	case LayoutRepo, LayoutSiblings:
+	default:
+		add("layout %q ไม่รองรับ (ใช้ได้: %s, %s)", s.Layout, LayoutRepo, LayoutSiblings)
+	}
+	if s.Layout == LayoutRepo && s.Name != "" {
+		if err := naming.Validate(s.Name, naming.Filesystem, naming.Docker); err != nil {
+			add("name: %v", err)
+		}
+	}
+	if len(s.Services) == 0 {
+		add("ต้องมี service อย่างน้อยหนึ่งตัวใน services")
+	}
+
+	names := map[string]bool{}
+	ports := map[int]string{}
+	for i, svc := range s.Services {
+		label := fmt.Sprintf("services[%d]", i)
+		if svc.Name != "" {
+			label = fmt.Sprintf("service %q", svc.Name)
+		}
+		if svc.Name == "" {
+			add("%s: ต้องระบุ name", label)
+		} else if names[svc.Name] {
+			add("%s: ชื่อซ้ำกับ service อื่น", label)
+		}
+		names[svc.Name] = true
+
+		fw, _, ok := config.FindFramework(svc.Framework)
+		switch {
+		case svc.Framework == "":
+			add("%s: ต้องระบุ framework", label)
+			continue
+		case !ok:
+			add("%s: ไม่รู้จัก framework %q", label, svc.Framework)
+			continue
+		case fw.Name == config.MonorepoName:
+			add("%s: ใช้ %q ใน spec ไม่ได้ ให้ระบุ frontend และ backend เป็น service แยกกัน", label, fw.Name)
+			continue
+		}
+		if svc.Name != "" {
+			if err := naming.Validate(svc.Name, naming.EcosystemsFor(fw.Runtime, fw.Language)...); err != nil {
+				add("%s: %v", label, err)
+			}
+		}
+		if svc.Variant != "" && !hasVariant(fw, svc.Variant) {
+			add("%s: %s ไม่มีรูปแบบ %q", label, fw.Name, svc.Variant)
+		}
+		for _, ex := range svc.Extras {
+			if _, ok := config.FindExtra(ex); !ok {
+				add("%s: ไม่รู้จัก extra %q", label, ex)
+			}
+		}
+		switch {
+		case svc.Port == 0:
+		case fw.Port < 0:
+			add("%s: %s ไม่ใช่ server จึงกำหนด port ไม่ได้", label, fw.Name)
+		case svc.Port < 1 || svc.Port > 65535:
+			add("%s: port %d ต้องอยู่ระหว่าง 1-65535", label, svc.Port)
+		case ports[svc.Port] != "":
+			add("%s: port %d ซ้ำกับ service %q", label, svc.Port, ports[svc.Port])
+		default:
+			ports[svc.Port] = svc.Name
+		}
+	}
+	for _, svc := range s.Services {
+		for _, dep := range svc.DependsOn {
+			if !names[dep] || dep == svc.Name {
+				add("service %q: depends_on %q ไม่ใช่ service อื่นใน spec", svc.Name, dep)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New("ไฟล์ spec ไม่ถูกต้อง:\n  - " + strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+func hasVariant(fw config.FrameworkOption, name string) bool {
+	for _, v := range fw.Variants {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr []string // ข้อความที่ต้องอยู่ใน error (ว่าง = ต้องผ่าน)
+	}{
+		{
+			name: "repo layout by default",
+			yaml: "name: shop\nservices:\n  - name: gateway\n    framework: go-gin\n  - name: orders\n    framework: fastapi-api\n    depends_on: [gateway]\n",
+		},
+		{
+			name: "siblings without name",
+			yaml: "layout: siblings\nservices:\n  - name: tool\n    framework: go-cli\n",
+		},
+		{
+			name:    "unknown layout",
+			yaml:    "layout: flat\nservices:\n  - name: api\n    framework: go-gin\n",
+			wantErr: []string{`layout "flat"`},
+		},
+		{
+			name:    "no services",
+			yaml:    "name: shop\n",
+			wantErr: []string{"อย่างน้อยหนึ่งตัว"},
+		},
+		{
+			name:    "unknown field",
+			yaml:    "name: shop\nservice: []\n",
+			wantErr: []string{"field service not found"},
+		},
+		{
+			name: "collects every problem",
+			yaml: "services:\n" +
+				"  - name: api\n    framework: go-gin\n    port: 9000\n" +
+				"  - name: api\n    framework: rails\n" +
+				"  - name: web\n    framework: fastapi-api\n    port: 9000\n    extras: [helm]\n" +
+				"  - name: tool\n    framework: go-cli\n    port: 7000\n    depends_on: [tool, db]\n" +
+				"  - name: mono\n    framework: monorepo\n",
+			wantErr: []string{
+				`service "api": ชื่อซ้ำ`,
+				`ไม่รู้จัก framework "rails"`,
+				`port 9000 ซ้ำกับ service "api"`,
+				`ไม่รู้จัก extra "helm"`,
+				"ไม่ใช่ server",
+				`depends_on "tool"`,
+				`depends_on "db"`,
+				`ใช้ "monorepo" ใน spec ไม่ได้`,
+			},
+		},
+		{
+			name:    "port out of range",
+			yaml:    "services:\n  - name: api\n    framework: go-gin\n    port: 70000\n",
+			wantErr: []string{"1-65535"},
+		},
+		{
+			name:    "invalid service name",
+			yaml:    "services:\n  - name: My API\n    framework: go-gin\n",
+			wantErr: []string{`service "My API"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse([]byte(tt.yaml))
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Parse: %v", err)
+				}
+				if s.Layout == "" {
+					t.Error("layout ว่างหลัง Parse")
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Parse ต้องคืน error")
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error ไม่มี %q:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -2,3 +2,181 @@ package templates
 
 // Template loading, rendering, and transforms.
 // Supports embedded and remote template sources and partials.
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Resolve ค้นหาโฟลเดอร์เทมเพลตจาก path ในแค็ตตาล็อก (เช่น templates/backend/go-fiber)
+// ลำดับการค้นหา: โฟลเดอร์ปัจจุบัน, sources ที่กำหนดใน config, และโฟลเดอร์ของไฟล์ executable
+// sources แต่ละตัวอาจชี้ไปที่ root ของ repo (มี templates/ อยู่ข้างใน) หรือชี้ไปที่ templates/ โดยตรง
+// โฟลเดอร์ว่างไม่นับว่าเป็นเทมเพลต คืนสตริงว่างหากไม่พบ
+func Resolve(rel string, sources []string) string {
+	if rel == "" {
+		return ""
+	}
+	if filepath.IsAbs(rel) {
+		if hasFiles(rel) {
+			return rel
+		}
+		return ""
+	}
+
+	roots := append([]string{"."}, sources...)
+	if exe, err := os.Executable(); err == nil {
+		roots = append(roots, filepath.Dir(exe))
+	}
+
+	trimmed := strings.TrimPrefix(filepath.ToSlash(rel), "templates/")
+	for _, root := range roots {
+		for _, candidate := range []string{
+			filepath.Join(root, rel),
+			filepath.Join(root, filepath.FromSlash(trimmed)),
+		} {
+			if hasFiles(candidate) {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+// Hash คำนวณ hash ของเนื้อหาเทมเพลตทั้งโฟลเดอร์ (รวมชื่อไฟล์) ในรูปแบบ sha256:<hex>
+// ใช้ตรวจว่าเทมเพลตเปลี่ยนไปจากตอนที่สร้างโปรเจ็กต์หรือไม่
+func Hash(dir string) (string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			rel, _ := filepath.Rel(dir, path)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, rel := range files {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(b)
+		h.Write([]byte(rel + "\x00" + hex.EncodeToString(sum[:]) + "\n"))
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Commit คืน git commit ล่าสุดที่แก้ไขโฟลเดอร์เทมเพลต (สตริงว่างหากไม่ได้อยู่ใน git repo)
+func Commit(ctx context.Context, dir string) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		return ""
+	}
+	out, err := gitOutput(ctx, dir, "log", "-1", "--format=%H", "--", ".")
+	if err != nil {
+		return ""
+	}
+	return out
+}
+
+// Export ดึงเนื้อหาเทมเพลตใน dir ณ git commit ที่ระบุ ไปไว้ในโฟลเดอร์ชั่วคราว
+// ผู้เรียกต้องลบโฟลเดอร์ที่คืนไปเองเมื่อใช้งานเสร็จ
+func Export(ctx context.Context, dir, commit string) (string, error) {
+	if commit == "" {
+		return "", errors.New("ไม่ได้ระบุ commit ของเทมเพลต")
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", errors.New("ไม่พบคำสั่ง git")
+	}
+	top, err := gitOutput(ctx, dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	prefix, err := gitOutput(ctx, dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "archive", "--format=tar", commit, "--", prefix)
+	cmd.Dir = top
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git archive %s: %s", commit, strings.TrimSpace(stderr.String()))
+	}
+
+	out, err := os.MkdirTemp("", "projgen-template-")
+	if err != nil {
+		return "", err
+	}
+	tr := tar.NewReader(&stdout)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			os.RemoveAll(out)
+			return "", err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		rel := strings.TrimPrefix(hdr.Name, prefix)
+		if rel == "" || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		dest := filepath.Join(out, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+			os.RemoveAll(out)
+			return "", err
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			os.RemoveAll(out)
+			return "", err
+		}
+		if err := os.WriteFile(dest, b, 0o644); err != nil {
+			os.RemoveAll(out)
+			return "", err
+		}
+	}
+	return out, nil
+}
+
+func gitOutput(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// hasFiles ตรวจว่า path เป็นโฟลเดอร์ที่มีไฟล์หรือโฟลเดอร์ย่อยอยู่ข้างใน
+func hasFiles(path string) bool {
+	entries, err := os.ReadDir(path)
+	return err == nil && len(entries) > 0
+}
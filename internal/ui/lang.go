@@ -0,0 +1,19 @@
+package ui
+
+// ภาษาของข้อความในวิซาร์ดและคำถามระหว่างสร้างโปรเจ็กต์ (ค่า lang ใน config)
+
+// lang ภาษาที่ใช้อยู่: th หรือ en
+var lang = "th"
+
+// SetLang กำหนดภาษาของ UI (ค่าที่ไม่รองรับถือเป็นภาษาไทย)
+func SetLang(l string) {
+	lang = l
+}
+
+// tr เลือกข้อความตามภาษาของ UI
+func tr(th, en string) string {
+	if lang == "en" {
+		return en
+	}
+	return th
+}
@@ -4,9 +4,13 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"projgen/internal/config"
+	"projgen/internal/license"
+	"projgen/internal/naming"
 	uiRuntime "projgen/internal/runtime"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -16,20 +20,40 @@ import (
 // ProjectOptions แทนตัวเลือกที่ผู้ใช้ระบุผ่านวิซาร์ด
 type ProjectOptions struct {
 	Name          string                  // ชื่อโปรเจ็กต์
-	ProjectType   config.ProjectType      // ประเภทโปรเจค (Frontend/Backend/Fullstack)
+	ProjectType   config.ProjectType      // ประเภทโปรเจค (Frontend/Backend/Fullstack/Library/Mobile/Desktop)
 	Framework     config.FrameworkOption  // framework ที่เลือก
 	CSSFramework  *config.CSSFrameworkOption // CSS framework (optional)
 	UILibrary     *config.UILibraryOption    // UI library (optional)
 	Language      string                  // ภาษา (สำหรับ fallback)
 	Runtime       string                  // รันไทม์ เช่น node, bun, deno, go
-	Extras        []string                // ตัวเลือกเสริม เช่น Dockerfile, ESLint
+	Extras        []string                // ตัวเลือกเสริม (Name จาก config.GetExtras) เช่น dockerfile, eslint
 	AutoInstall   bool                    // ติดตั้ง dependencies อัตโนมัติหรือไม่
+	PackageManager string                 // package manager สำหรับโปรเจ็กต์ Node (npm, pnpm, yarn, bun)
+	AuthorName    string                  // ชื่อผู้เขียน (จาก config)
+	AuthorEmail   string                  // อีเมลผู้เขียน (จาก config)
+	License       string                  // license (SPDX identifier หรือ proprietary)
+	LicenseYear   int                     // ปีในบรรทัด copyright ของ LICENSE (กำหนดตอนสร้าง เพื่อให้ update เรนเดอร์ได้เหมือนเดิม)
+	GoModule      string                  // Go module path (เฉพาะโปรเจ็กต์ Go)
+	Variant       string                  // รูปแบบโครงสร้างโปรเจ็กต์ (Name จาก Framework.Variants)
+	Frontend      *config.FrameworkOption // monorepo: แอป frontend ใน apps/web, Tauri: เทมเพลต Vite ที่ใช้เป็นฐาน
+	Backend       *config.FrameworkOption // monorepo: แอป backend ใน apps/api
+	Description   string                  // library: คำอธิบายสั้น ๆ สำหรับ package.json/README
+	Repository    string                  // library: URL ของ repository (ว่าง = ไม่ระบุ)
+	GitInit       bool                    // สร้าง git repository พร้อม commit แรก
+	GitRemote     string                  // URL ของ remote origin (ว่าง = ไม่เพิ่ม remote)
 }
 
-// RunWizard เรียกใช้งานวิซาร์ดแบบโต้ตอบเพื่อเก็บตัวเลือกจากผู้ใช้ (ภาษาไทยทั้งหมด)
-func RunWizard(ctx context.Context) (ProjectOptions, error) {
+// RunWizard เรียกใช้งานวิซาร์ดแบบโต้ตอบเพื่อเก็บตัวเลือกจากผู้ใช้ (ภาษาตาม lang ใน config)
+// ค่าเริ่มต้นต่าง ๆ เช่น ตัวเลือกเสริมและ package manager มาจาก cfg
+// name ที่ไม่ว่าง (เช่น ชื่อโฟลเดอร์เมื่อใช้ projgen create .) จะถูกใช้เป็นชื่อโปรเจ็กต์โดยไม่ถาม
+func RunWizard(ctx context.Context, cfg *config.Config, name string) (ProjectOptions, error) {
+	SetLang(cfg.Lang)
 	var opts ProjectOptions
 	opts.AutoInstall = true // default ให้ติดตั้งอัตโนมัติ
+	opts.PackageManager = cfg.PackageManager
+	opts.AuthorName = cfg.AuthorName
+	opts.AuthorEmail = cfg.AuthorEmail
+	opts.License = cfg.License
 
 	// สร้าง header แบบสวยงามด้วยสีสันแต่ไม่มีพื้นหลัง
 	titleStyle := pterm.NewStyle(pterm.FgCyan, pterm.Bold)
@@ -41,17 +65,18 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 	pterm.DefaultCenter.Println(titleStyle.Sprint("╚══════════════════════════════════════════════════════════╝"))
 	pterm.Println()
 	
-	pterm.DefaultCenter.Println(pterm.LightGreen("✨ สร้างโปรเจ็กต์ของคุณในไม่กี่วินาที ✨"))
+	pterm.DefaultCenter.Println(pterm.LightGreen(tr("✨ สร้างโปรเจ็กต์ของคุณในไม่กี่วินาที ✨", "✨ Create your project in seconds ✨")))
 	pterm.Println()
 
 	// 1) เลือกประเภทโปรเจค
+	projectTypes := config.ProjectTypes()
+	projectTypeOptions := make([]string, len(projectTypes))
+	for i, pt := range projectTypes {
+		projectTypeOptions[i] = string(pt)
+	}
 	projectTypePrompt := &survey.Select{
-		Message: "🎯 คุณต้องการสร้างโปรเจคประเภทไหน?",
-		Options: []string{
-			string(config.Frontend),
-			string(config.Backend),
-			string(config.Fullstack),
-		},
+		Message: tr("🎯 คุณต้องการสร้างโปรเจคประเภทไหน?", "🎯 What type of project do you want to create?"),
+		Options: projectTypeOptions,
 		Default: string(config.Frontend),
 	}
 	var projectTypeStr string
@@ -61,15 +86,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 	opts.ProjectType = config.ProjectType(projectTypeStr)
 
 	// 2) เลือก Framework ตามประเภทโปรเจค
-	var frameworks []config.FrameworkOption
-	switch opts.ProjectType {
-	case config.Frontend:
-		frameworks = config.GetFrontendFrameworks()
-	case config.Backend:
-		frameworks = config.GetBackendFrameworks()
-	case config.Fullstack:
-		frameworks = config.GetFullstackFrameworks()
-	}
+	frameworks := config.FrameworksFor(opts.ProjectType)
 
 	frameworkOptions := make([]string, len(frameworks))
 	for i, fw := range frameworks {
@@ -77,7 +94,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 	}
 
 	frameworkPrompt := &survey.Select{
-		Message: "🛠️  เลือก Framework/Stack:",
+		Message: tr("🛠️  เลือก Framework/Stack:", "🛠️  Select a framework/stack:"),
 		Options: frameworkOptions,
 		Description: func(value string, index int) string {
 			if index < len(frameworks) {
@@ -99,6 +116,54 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 		}
 	}
 
+	// เลือกรูปแบบโครงสร้างโปรเจ็กต์ (ถ้าเทมเพลตมีให้เลือก) variant ที่เป็นรันไทม์จะถามหลังตรวจรันไทม์
+	if len(opts.Framework.Variants) > 0 && !config.RuntimeVariants(opts.Framework) {
+		variants := opts.Framework.Variants
+		variantOptions := make([]string, len(variants))
+		for i, v := range variants {
+			variantOptions[i] = v.DisplayName
+		}
+		variantPrompt := &survey.Select{
+			Message: tr("🗂️  เลือกรูปแบบโครงสร้างโปรเจ็กต์:", "🗂️  Select a project layout:"),
+			Options: variantOptions,
+			Default: variantOptions[0],
+			Description: func(value string, index int) string {
+				return variants[index].Description
+			},
+		}
+		var selectedVariant string
+		if err := survey.AskOne(variantPrompt, &selectedVariant); err != nil {
+			return ProjectOptions{}, err
+		}
+		for _, v := range variants {
+			if v.DisplayName == selectedVariant {
+				opts.Variant = v.Name
+			}
+		}
+	}
+
+	// monorepo: เลือกเทมเพลต frontend และ backend ที่จะรวมไว้ใน repository เดียว
+	if opts.Framework.Name == config.MonorepoName {
+		frontend, err := selectFramework(tr("🎨 เลือก Frontend (apps/web):", "🎨 Select the frontend (apps/web):"), config.MonorepoFrontends())
+		if err != nil {
+			return ProjectOptions{}, err
+		}
+		backend, err := selectFramework(tr("🔧 เลือก Backend (apps/api):", "🔧 Select the backend (apps/api):"), config.MonorepoBackends())
+		if err != nil {
+			return ProjectOptions{}, err
+		}
+		opts.Frontend, opts.Backend = &frontend, &backend
+	}
+
+	// แอปเดสก์ท็อปที่ต่อยอดจาก frontend (เช่น Tauri): เลือกเทมเพลต Vite ที่ใช้เป็นหน้าจอของแอป
+	if opts.Framework.FrontendBase {
+		frontend, err := selectFramework(tr("🎨 เลือก Frontend ของแอป (Vite):", "🎨 Select the app frontend (Vite):"), config.ViteFrontends())
+		if err != nil {
+			return ProjectOptions{}, err
+		}
+		opts.Frontend = &frontend
+	}
+
 	// 3) ถ้าเป็น Frontend ให้เลือก CSS Framework (ถ้า framework รองรับ)
 	if opts.ProjectType == config.Frontend && len(opts.Framework.SupportedAddons) > 0 {
 		// ตรวจสอบว่ารองรับ CSS framework หรือไม่
@@ -118,7 +183,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 			}
 
 			cssPrompt := &survey.Select{
-				Message: "🎨 ต้องการเพิ่ม CSS Framework หรือไม่?",
+				Message: tr("🎨 ต้องการเพิ่ม CSS Framework หรือไม่?", "🎨 Add a CSS framework?"),
 				Options: cssOptions,
 				Default: "None (Skip CSS framework)",
 			}
@@ -145,7 +210,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 			}
 
 			uiPrompt := &survey.Select{
-				Message: "🧩 ต้องการเพิ่ม UI Library หรือไม่?",
+				Message: tr("🧩 ต้องการเพิ่ม UI Library หรือไม่?", "🧩 Add a UI library?"),
 				Options: uiOptions,
 				Default: "None",
 			}
@@ -166,7 +231,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 
 	// 5) ตรวจจับรันไทม์ (แสดงสปินเนอร์ระหว่างตรวจสอบ)
 	pterm.Println()
-	spinner, _ := pterm.DefaultSpinner.Start("🔍 กำลังตรวจสอบสภาพแวดล้อมรันไทม์...")
+	spinner, _ := pterm.DefaultSpinner.Start(tr("🔍 กำลังตรวจสอบสภาพแวดล้อมรันไทม์...", "🔍 Checking runtime environment..."))
 	runtimeDetected := uiRuntime.Detect(ctx)
 	spinner.Stop()
 
@@ -174,12 +239,12 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 		pterm.Warning.WithPrefix(pterm.Prefix{
 			Text:  " WARNING ",
 			Style: pterm.NewStyle(pterm.FgBlack, pterm.BgYellow),
-		}).Println("ไม่พบรันไทม์ที่รองรับ (Node, Bun, Deno หรือ Go) บนเครื่อง")
+		}).Println(tr("ไม่พบรันไทม์ที่รองรับ (Node, Bun, Deno หรือ Go) บนเครื่อง", "No supported runtime (Node, Bun, Deno or Go) found on this machine"))
 	} else {
 		pterm.Success.WithPrefix(pterm.Prefix{
 			Text:  " SUCCESS ",
 			Style: pterm.NewStyle(pterm.FgBlack, pterm.BgGreen),
-		}).Printfln("ตรวจพบรันไทม์: %s", pterm.Cyan(runtimeDetected))
+		}).Printfln(tr("ตรวจพบรันไทม์: %s", "Detected runtime: %s"), pterm.Cyan(runtimeDetected))
 	}
 	opts.Runtime = runtimeDetected
 
@@ -187,54 +252,176 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 	statuses := uiRuntime.InspectAll(ctx)
 	uiRuntime.PrintReport(statuses)
 
-	// 6) ตั้งชื่อโปรเจ็กต์
-	namePrompt := &survey.Input{
-		Message: "📝 ตั้งชื่อโปรเจ็กต์:",
-		Default: "my-app",
-	}
-	if err := survey.AskOne(namePrompt, &opts.Name, survey.WithValidator(survey.Required)); err != nil {
+	// เลือกรันไทม์จากที่ framework รองรับเท่านั้น (เช่น Hono: Bun/Deno/Node, Fresh: Deno)
+	if err := selectRuntime(ctx, &opts, runtimeDetected); err != nil {
 		return ProjectOptions{}, err
 	}
 
+	// 6) ตั้งชื่อโปรเจ็กต์ (ต้องใช้ได้ทั้งเป็นชื่อโฟลเดอร์, Docker image และ npm package/Go module)
+	ecosystems := naming.EcosystemsFor(opts.Runtime, opts.Framework.Language)
+	if name != "" {
+		if err := naming.Validate(name, ecosystems...); err != nil {
+			return ProjectOptions{}, err
+		}
+		opts.Name = name
+		pterm.Info.Printfln(tr("📝 ชื่อโปรเจ็กต์: %s", "📝 Project name: %s"), pterm.LightGreen(name))
+	} else {
+		namePrompt := &survey.Input{
+			Message: tr("📝 ตั้งชื่อโปรเจ็กต์:", "📝 Project name:"),
+			Default: "my-app",
+		}
+		validName := func(ans interface{}) error {
+			return naming.Validate(strings.TrimSpace(ans.(string)), ecosystems...)
+		}
+		if err := survey.AskOne(namePrompt, &opts.Name, survey.WithValidator(validName)); err != nil {
+			return ProjectOptions{}, err
+		}
+		opts.Name = strings.TrimSpace(opts.Name)
+	}
+
+	// Go module path (ค่าเริ่มต้นจาก go-module-prefix ใน config)
+	if strings.EqualFold(opts.Framework.Language, "Go") || (opts.Backend != nil && strings.EqualFold(opts.Backend.Language, "Go")) {
+		defaultModule := opts.Name
+		if cfg.GoModulePrefix != "" {
+			defaultModule = cfg.GoModulePrefix + "/" + opts.Name
+		}
+		modulePrompt := &survey.Input{
+			Message: "📦 Go module path:",
+			Default: defaultModule,
+		}
+		validModule := func(ans interface{}) error {
+			return naming.ValidateGoModule(strings.TrimSpace(ans.(string)))
+		}
+		if err := survey.AskOne(modulePrompt, &opts.GoModule, survey.WithValidator(validModule)); err != nil {
+			return ProjectOptions{}, err
+		}
+		opts.GoModule = strings.TrimSpace(opts.GoModule)
+	}
+
+	// license ของโปรเจ็กต์ (ข้ามเมื่อระบุ --license มาแล้ว)
+	if _, src, _ := cfg.Get("license"); src != config.SourceFlag {
+		if err := selectLicense(&opts); err != nil {
+			return ProjectOptions{}, err
+		}
+	}
+
+	// ข้อมูลสำหรับ publish library (คำอธิบาย, repository)
+	if opts.ProjectType == config.Library {
+		if err := askLibraryMetadata(cfg, &opts); err != nil {
+			return ProjectOptions{}, err
+		}
+	}
+
 	// 7) เลือกตัวเลือกเสริม
-	extras := config.GetExtras()
+	// แอปมือถือ/เดสก์ท็อปและ library ไม่ได้รันใน container จึงไม่มีตัวเลือก Docker
+	// ตัวเลือก CI แสดงเฉพาะของผู้ให้บริการใน ci-provider (none = ไม่แสดง)
+	ciExtra := cfg.CIExtra()
+	containerless := opts.ProjectType == config.Mobile || opts.ProjectType == config.Desktop || opts.ProjectType == config.Library
+	var extras []config.ExtraOption
+	for _, ex := range config.GetExtras() {
+		if containerless && strings.HasPrefix(ex.Name, "docker") {
+			continue
+		}
+		if config.IsCIExtra(ex.Name) && ex.Name != ciExtra {
+			continue
+		}
+		extras = append(extras, ex)
+	}
 	extraOptions := make([]string, len(extras))
+	var defaultExtras []string
 	for i, ex := range extras {
 		extraOptions[i] = ex.DisplayName
+		for _, name := range cfg.DefaultExtras {
+			if name == ex.Name || name == ex.DisplayName {
+				defaultExtras = append(defaultExtras, ex.DisplayName)
+			}
+		}
+		// library เลือก CI ไว้ล่วงหน้า เพราะ workflow ทดสอบกับหลายเวอร์ชันของรันไทม์ก่อน publish
+		if opts.ProjectType == config.Library && ex.Name == ciExtra && !contains(defaultExtras, ex.DisplayName) {
+			defaultExtras = append(defaultExtras, ex.DisplayName)
+		}
 	}
 
 	extrasPrompt := &survey.MultiSelect{
-		Message: "⚙️  เลือกตัวเลือกเสริม (เลือกได้หลายข้อ):",
+		Message: tr("⚙️  เลือกตัวเลือกเสริม (เลือกได้หลายข้อ):", "⚙️  Select extras (multiple allowed):"),
 		Options: extraOptions,
+		Default: defaultExtras,
 	}
 	var selectedExtras []string
 	if err := survey.AskOne(extrasPrompt, &selectedExtras); err != nil {
 		return ProjectOptions{}, err
 	}
-	opts.Extras = selectedExtras
+	// เก็บเป็น Name ของ extra (เช่น dockerfile, env) เพื่อให้ generator และ projgen add ใช้ร่วมกันได้
+	for _, display := range selectedExtras {
+		if ex, ok := config.FindExtra(display); ok {
+			opts.Extras = append(opts.Extras, ex.Name)
+		}
+	}
 
 	// 8) ถามว่าต้องการติดตั้ง dependencies อัตโนมัติหรือไม่
 	autoInstallPrompt := &survey.Confirm{
-		Message: "📦 ต้องการติดตั้ง dependencies อัตโนมัติหลังสร้างโปรเจคหรือไม่?",
+		Message: tr("📦 ต้องการติดตั้ง dependencies อัตโนมัติหลังสร้างโปรเจคหรือไม่?", "📦 Install dependencies after creating the project?"),
 		Default: true,
 	}
 	if err := survey.AskOne(autoInstallPrompt, &opts.AutoInstall); err != nil {
 		return ProjectOptions{}, err
 	}
 
+	// git repository พร้อม commit แรก (ข้ามเมื่อระบุ --no-git หรือ --set git.init มาแล้ว)
+	opts.GitInit = cfg.GitInit
+	if _, src, _ := cfg.Get("git.init"); src != config.SourceFlag {
+		gitPrompt := &survey.Confirm{
+			Message: tr("🌱 สร้าง git repository พร้อม commit แรกหรือไม่?", "🌱 Create a git repository with an initial commit?"),
+			Default: cfg.GitInit,
+		}
+		if err := survey.AskOne(gitPrompt, &opts.GitInit); err != nil {
+			return ProjectOptions{}, err
+		}
+	}
+	if opts.GitInit {
+		opts.GitRemote = cfg.GitRemote
+		if opts.GitRemote == "" && cfg.GitRemotePrefix != "" {
+			opts.GitRemote = cfg.GitRemotePrefix + "/" + opts.Name + ".git"
+		}
+	}
+
 	// 9) แสดงสรุปก่อนสร้าง
 	pterm.Println()
 	pterm.Println(pterm.LightCyan("─────────────────────────────────────────────────────────────"))
-	pterm.DefaultSection.WithStyle(pterm.NewStyle(pterm.FgLightCyan)).Println("📋 สรุปการตั้งค่า")
+	pterm.DefaultSection.WithStyle(pterm.NewStyle(pterm.FgLightCyan)).Println(tr("📋 สรุปการตั้งค่า", "📋 Summary"))
 	
 	// สร้างตารางสวยๆ ด้วยสี
 	tableData := pterm.TableData{
-		{pterm.LightMagenta("รายการ"), pterm.LightMagenta("ค่า")},
-		{pterm.Cyan("ชื่อโปรเจ็กต์"), pterm.LightGreen(opts.Name)},
-		{pterm.Cyan("ประเภทโปรเจค"), pterm.LightYellow(string(opts.ProjectType))},
+		{pterm.LightMagenta(tr("รายการ", "Setting")), pterm.LightMagenta(tr("ค่า", "Value"))},
+		{pterm.Cyan(tr("ชื่อโปรเจ็กต์", "Project name")), pterm.LightGreen(opts.Name)},
+		{pterm.Cyan(tr("ประเภทโปรเจค", "Project type")), pterm.LightYellow(string(opts.ProjectType))},
 		{pterm.Cyan("Framework"), pterm.LightBlue(opts.Framework.DisplayName)},
-		{pterm.Cyan("ภาษา"), pterm.White(opts.Framework.Language)},
-		{pterm.Cyan("รันไทม์"), pterm.LightGreen(opts.Runtime)},
+		{pterm.Cyan(tr("ภาษา", "Language")), pterm.White(opts.Framework.Language)},
+		{pterm.Cyan(tr("รันไทม์", "Runtime")), pterm.LightGreen(opts.Runtime)},
+	}
+
+	if opts.GoModule != "" {
+		tableData = append(tableData, []string{pterm.Cyan("Go module"), pterm.White(opts.GoModule)})
+	}
+	for _, v := range opts.Framework.Variants {
+		if v.Name == opts.Variant && v.Runtime == "" {
+			tableData = append(tableData, []string{pterm.Cyan(tr("โครงสร้าง", "Layout")), pterm.White(v.DisplayName)})
+		}
+	}
+	if opts.Frontend != nil {
+		tableData = append(tableData, []string{pterm.Cyan("Frontend"), pterm.LightBlue(opts.Frontend.DisplayName)})
+	}
+	if opts.Backend != nil {
+		tableData = append(tableData, []string{pterm.Cyan("Backend"), pterm.LightBlue(opts.Backend.DisplayName)})
+	}
+	if opts.License != "" {
+		tableData = append(tableData, []string{pterm.Cyan("License"), pterm.White(opts.License)})
+	}
+	if opts.Repository != "" {
+		tableData = append(tableData, []string{pterm.Cyan("Repository"), pterm.White(opts.Repository)})
+	}
+	if opts.Framework.Runtime == "node" && opts.PackageManager != "" {
+		tableData = append(tableData, []string{pterm.Cyan("Package manager"), pterm.White(opts.PackageManager)})
 	}
 
 	if opts.CSSFramework != nil {
@@ -244,13 +431,21 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 		tableData = append(tableData, []string{pterm.Cyan("UI Library"), pterm.LightBlue(opts.UILibrary.DisplayName)})
 	}
 	if len(opts.Extras) > 0 {
-		tableData = append(tableData, []string{pterm.Cyan("ตัวเลือกเสริม"), pterm.Yellow(fmt.Sprintf("%d รายการ", len(opts.Extras)))})
+		tableData = append(tableData, []string{pterm.Cyan(tr("ตัวเลือกเสริม", "Extras")), pterm.Yellow(fmt.Sprintf(tr("%d รายการ", "%d selected"), len(opts.Extras)))})
 	}
-	autoInstallText := "❌ ไม่"
+	autoInstallText := tr("❌ ไม่", "❌ No")
 	if opts.AutoInstall {
-		autoInstallText = "✅ ใช่"
+		autoInstallText = tr("✅ ใช่", "✅ Yes")
 	}
-	tableData = append(tableData, []string{pterm.Cyan("ติดตั้งอัตโนมัติ"), autoInstallText})
+	tableData = append(tableData, []string{pterm.Cyan(tr("ติดตั้งอัตโนมัติ", "Auto install")), autoInstallText})
+	gitText := tr("❌ ไม่", "❌ No")
+	if opts.GitInit {
+		gitText = "✅ " + cfg.GitBranch
+		if opts.GitRemote != "" {
+			gitText += " → " + opts.GitRemote
+		}
+	}
+	tableData = append(tableData, []string{pterm.Cyan("Git"), gitText})
 
 	// แสดงตารางแบบสวยงาม
 	pterm.DefaultTable.
@@ -265,7 +460,7 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 
 	// 10) ยืนยันก่อนเริ่มสร้าง
 	confirmPrompt := &survey.Confirm{
-		Message: "🚀 เริ่มสร้างโปรเจ็กต์เลยไหม?",
+		Message: tr("🚀 เริ่มสร้างโปรเจ็กต์เลยไหม?", "🚀 Create the project now?"),
 		Default: true,
 	}
 	var confirm bool
@@ -273,9 +468,203 @@ func RunWizard(ctx context.Context) (ProjectOptions, error) {
 		return ProjectOptions{}, err
 	}
 	if !confirm {
-		pterm.Info.Println("ยกเลิกการสร้างโปรเจ็กต์")
+		pterm.Info.Println(tr("ยกเลิกการสร้างโปรเจ็กต์", "Project creation cancelled"))
 		return ProjectOptions{}, fmt.Errorf("ยกเลิกโดยผู้ใช้")
 	}
 
 	return opts, nil
 }
+
+// ConfirmOverwrite ถามผู้ใช้ว่าจะเขียนทับไฟล์ที่มีอยู่แล้วหรือไม่
+func ConfirmOverwrite(rel string) (bool, error) {
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf(tr("⚠️  มีไฟล์ %s อยู่แล้ว ต้องการเขียนทับหรือไม่?", "⚠️  %s already exists. Overwrite it?"), rel),
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// ResolveConflict ถามผู้ใช้ว่าจะจัดการไฟล์จากเทมเพลตที่ชนกับไฟล์เดิมอย่างไร
+// คืน "skip", "overwrite" หรือ "keep-both" และเลือก "ดู diff" ซ้ำได้จนกว่าจะตัดสินใจ
+func ResolveConflict(rel string, showDiff func()) (string, error) {
+	options := []string{tr("เก็บไฟล์เดิม", "Keep existing file"), tr("เขียนทับด้วยไฟล์จากเทมเพลต", "Overwrite with template file"), tr("เก็บทั้งสองไฟล์", "Keep both files"), tr("ดู diff", "Show diff")}
+	values := map[string]string{
+		options[0]: "skip",
+		options[1]: "overwrite",
+		options[2]: "keep-both",
+	}
+	for {
+		var choice string
+		prompt := &survey.Select{
+			Message: fmt.Sprintf(tr("⚠️  มีไฟล์ %s อยู่แล้ว จะจัดการอย่างไร?", "⚠️  %s already exists. What should happen?"), rel),
+			Options: options,
+			Default: options[0],
+		}
+		if err := survey.AskOne(prompt, &choice); err != nil {
+			return "", err
+		}
+		if v, ok := values[choice]; ok {
+			return v, nil
+		}
+		showDiff()
+	}
+}
+
+// selectRuntime ให้เลือกรันไทม์เฉพาะที่ framework ใช้ได้ ค่าเริ่มต้นคือรันไทม์ที่ตรวจพบถ้าเข้ากันได้
+// ถ้า framework รองรับรันไทม์เดียว จะใช้รันไทม์นั้นและเตือนเมื่อไม่พบบนเครื่อง
+func selectRuntime(ctx context.Context, opts *ProjectOptions, detected string) error {
+	runtimes := config.CompatibleRuntimes(opts.Framework)
+	if len(runtimes) == 0 {
+		return nil
+	}
+	if len(runtimes) == 1 {
+		opts.Runtime = runtimes[0]
+		if !uiRuntime.CheckRuntime(ctx, runtimes[0]).Found {
+			pterm.Warning.Printfln(tr("%s ต้องใช้ %s แต่ไม่พบบนเครื่องนี้", "%s requires %s, which was not found on this machine"), opts.Framework.DisplayName, runtimes[0])
+		}
+		return nil
+	}
+
+	descriptions := make([]string, len(runtimes))
+	defaultRuntime := runtimes[0]
+	for i, rt := range runtimes {
+		status := uiRuntime.CheckRuntime(ctx, rt)
+		switch {
+		case !status.Found:
+			descriptions[i] = tr("ไม่พบบนเครื่อง", "not installed")
+		case status.Version != "":
+			descriptions[i] = tr("พบบนเครื่อง v", "installed v") + status.Version
+		default:
+			descriptions[i] = tr("พบบนเครื่อง", "installed")
+		}
+		if rt == detected {
+			defaultRuntime = rt
+		}
+	}
+	runtimePrompt := &survey.Select{
+		Message: tr("⚙️  เลือกรันไทม์:", "⚙️  Select a runtime:"),
+		Options: runtimes,
+		Default: defaultRuntime,
+		Description: func(value string, index int) string {
+			return descriptions[index]
+		},
+	}
+	if err := survey.AskOne(runtimePrompt, &opts.Runtime); err != nil {
+		return err
+	}
+	for _, v := range opts.Framework.Variants {
+		if v.Runtime == opts.Runtime {
+			opts.Variant = v.Name
+		}
+	}
+	return nil
+}
+
+// selectFramework ให้เลือก framework หนึ่งตัวจากรายการ พร้อมคำอธิบายของแต่ละตัว
+func selectFramework(message string, frameworks []config.FrameworkOption) (config.FrameworkOption, error) {
+	options := make([]string, len(frameworks))
+	for i, fw := range frameworks {
+		options[i] = fw.DisplayName
+	}
+	prompt := &survey.Select{
+		Message: message,
+		Options: options,
+		Description: func(value string, index int) string {
+			return frameworks[index].Description
+		},
+	}
+	var selected string
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return config.FrameworkOption{}, err
+	}
+	for _, fw := range frameworks {
+		if fw.DisplayName == selected {
+			return fw, nil
+		}
+	}
+	return config.FrameworkOption{}, fmt.Errorf("ไม่พบ framework %q", selected)
+}
+
+// askLibraryMetadata ถามคำอธิบายและ URL ของ repository ที่จะใส่ใน metadata สำหรับ publish
+// repository เริ่มต้นมาจาก Go module path หรือ go-module-prefix เมื่ออยู่บน GitHub/GitLab
+func askLibraryMetadata(cfg *config.Config, opts *ProjectOptions) error {
+	descPrompt := &survey.Input{
+		Message: tr("📝 คำอธิบาย library:", "📝 Library description:"),
+	}
+	if err := survey.AskOne(descPrompt, &opts.Description); err != nil {
+		return err
+	}
+	opts.Description = strings.TrimSpace(opts.Description)
+
+	repoPrompt := &survey.Input{
+		Message: tr("🔗 URL ของ repository (เว้นว่างได้):", "🔗 Repository URL (optional):"),
+		Default: defaultRepository(cfg, *opts),
+	}
+	validRepo := func(ans interface{}) error {
+		v := strings.TrimSpace(ans.(string))
+		if v != "" && !strings.HasPrefix(v, "https://") && !strings.HasPrefix(v, "git@") {
+			return errors.New(tr("URL ต้องขึ้นต้นด้วย https:// หรือ git@", "URL must start with https:// or git@"))
+		}
+		return nil
+	}
+	if err := survey.AskOne(repoPrompt, &opts.Repository, survey.WithValidator(validRepo)); err != nil {
+		return err
+	}
+	opts.Repository = strings.TrimSuffix(strings.TrimSpace(opts.Repository), "/")
+	return nil
+}
+
+// selectLicense ให้เลือก license จากชุดที่มีข้อความให้สร้าง LICENSE (ค่าเริ่มต้นจาก config)
+// license ใน config ที่ไม่มีข้อความ (เช่น ISC) ยังเลือกได้ แต่จะไม่มีไฟล์ LICENSE
+func selectLicense(opts *ProjectOptions) error {
+	licenses := license.All()
+	options := make([]string, 0, len(licenses)+1)
+	names := make([]string, 0, len(licenses)+1)
+	if _, ok := license.Find(opts.License); opts.License != "" && !ok {
+		options = append(options, opts.License)
+		names = append(names, tr("ไม่มีข้อความใน projgen — จะไม่สร้างไฟล์ LICENSE", "no text in projgen — LICENSE will not be created"))
+	}
+	for _, l := range licenses {
+		options = append(options, l.ID)
+		names = append(names, l.Name)
+	}
+	prompt := &survey.Select{
+		Message: tr("📄 เลือก license:", "📄 Select a license:"),
+		Options: options,
+		Default: options[0],
+		Description: func(value string, index int) string {
+			return names[index]
+		},
+	}
+	if l, ok := license.Find(opts.License); ok {
+		prompt.Default = l.ID
+	}
+	return survey.AskOne(prompt, &opts.License)
+}
+
+// defaultRepository เดา URL ของ repository จาก Go module path หรือ go-module-prefix
+func defaultRepository(cfg *config.Config, opts ProjectOptions) string {
+	path := opts.GoModule
+	if path == "" && cfg.GoModulePrefix != "" {
+		path = cfg.GoModulePrefix + "/" + opts.Name
+	}
+	for _, host := range []string{"github.com/", "gitlab.com/", "bitbucket.org/"} {
+		if strings.HasPrefix(path, host) {
+			return "https://" + path
+		}
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
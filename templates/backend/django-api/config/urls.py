@@ -0,0 +1,8 @@
+from django.urls import path
+
+from core import views
+
+urlpatterns = [
+    path("", views.root),
+    path("health", views.health),
+]
@@ -0,0 +1,10 @@
+def test_health(client):
+    response = client.get("/health")
+    assert response.status_code == 200
+    assert response.json() == {"status": "ok"}
+
+
+def test_root(client):
+    response = client.get("/")
+    assert response.status_code == 200
+    assert "message" in response.json()
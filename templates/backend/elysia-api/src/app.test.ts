@@ -0,0 +1,21 @@
+import { describe, expect, test } from "bun:test";
+import { app } from "./app.ts";
+
+describe("app", () => {
+  test("GET /health returns ok", async () => {
+    const res = await app.handle(new Request("http://localhost/health"));
+    expect(res.status).toBe(200);
+    expect(await res.json()).toEqual({ status: "ok" });
+  });
+
+  test("POST /echo validates the body", async () => {
+    const res = await app.handle(
+      new Request("http://localhost/echo", {
+        method: "POST",
+        headers: { "content-type": "application/json" },
+        body: JSON.stringify({ nope: true }),
+      }),
+    );
+    expect(res.status).toBe(422);
+  });
+});
@@ -0,0 +1,17 @@
+from fastapi import FastAPI
+
+from app.config import get_settings
+
+settings = get_settings()
+
+app = FastAPI(title=settings.app_name)
+
+
+@app.get("/")
+def root() -> dict[str, str]:
+    return {"message": f"Hello from {settings.app_name}"}
+
+
+@app.get("/health")
+def health() -> dict[str, str]:
+    return {"status": "ok"}
@@ -0,0 +1,21 @@
+import pytest
+
+from app import create_app
+
+
+@pytest.fixture
+def client():
+    app = create_app({"TESTING": True})
+    return app.test_client()
+
+
+def test_health(client):
+    response = client.get("/health")
+    assert response.status_code == 200
+    assert response.get_json() == {"status": "ok"}
+
+
+def test_root(client):
+    response = client.get("/")
+    assert response.status_code == 200
+    assert "message" in response.get_json()
@@ -0,0 +1,16 @@
+import { describe, expect, test } from "bun:test";
+import app from "./app.ts";
+
+describe("app", () => {
+  test("GET /health returns ok", async () => {
+    const res = await app.request("/health");
+    expect(res.status).toBe(200);
+    expect(await res.json()).toEqual({ status: "ok" });
+  });
+
+  test("GET / returns a message", async () => {
+    const res = await app.request("/");
+    expect(res.status).toBe(200);
+    expect(await res.json()).toHaveProperty("message");
+  });
+});
@@ -0,0 +1,14 @@
+import { assertEquals, assertExists } from "@std/assert";
+import app from "./app.ts";
+
+Deno.test("GET /health returns ok", async () => {
+  const res = await app.request("/health");
+  assertEquals(res.status, 200);
+  assertEquals(await res.json(), { status: "ok" });
+});
+
+Deno.test("GET / returns a message", async () => {
+  const res = await app.request("/");
+  assertEquals(res.status, 200);
+  assertExists((await res.json()).message);
+});
@@ -0,0 +1,15 @@
+import assert from "node:assert/strict";
+import { test } from "node:test";
+import app from "./app.ts";
+
+test("GET /health returns ok", async () => {
+  const res = await app.request("/health");
+  assert.equal(res.status, 200);
+  assert.deepEqual(await res.json(), { status: "ok" });
+});
+
+test("GET / returns a message", async () => {
+  const res = await app.request("/");
+  assert.equal(res.status, 200);
+  assert.ok((await res.json()).message);
+});
@@ -0,0 +1,22 @@
+package com.example.app;
+
+import java.util.Map;
+
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.web.bind.annotation.GetMapping;
+import org.springframework.web.bind.annotation.RestController;
+
+@RestController
+public class HelloController {
+
+    private final String appName;
+
+    public HelloController(@Value("${spring.application.name}") String appName) {
+        this.appName = appName;
+    }
+
+    @GetMapping("/")
+    public Map<String, String> root() {
+        return Map.of("message", "Hello from " + appName);
+    }
+}
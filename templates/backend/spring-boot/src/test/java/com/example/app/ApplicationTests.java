@@ -0,0 +1,35 @@
+package com.example.app;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import java.util.Map;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.boot.test.web.client.TestRestTemplate;
+import org.springframework.http.HttpStatus;
+import org.springframework.http.ResponseEntity;
+
+@SpringBootTest(webEnvironment = SpringBootTest.WebEnvironment.RANDOM_PORT)
+class ApplicationTests {
+
+    @Autowired
+    private TestRestTemplate rest;
+
+    @Test
+    @SuppressWarnings("rawtypes")
+    void healthIsUp() {
+        ResponseEntity<Map> response = rest.getForEntity("/actuator/health", Map.class);
+        assertThat(response.getStatusCode()).isEqualTo(HttpStatus.OK);
+        assertThat(response.getBody()).containsEntry("status", "UP");
+    }
+
+    @Test
+    @SuppressWarnings("rawtypes")
+    void rootReturnsMessage() {
+        ResponseEntity<Map> response = rest.getForEntity("/", Map.class);
+        assertThat(response.getStatusCode()).isEqualTo(HttpStatus.OK);
+        assertThat(response.getBody()).containsKey("message");
+    }
+}
@@ -0,0 +1,41 @@
+const path = require('node:path');
+const { app, BrowserWindow, ipcMain, shell } = require('electron');
+
+const { windowOptions, isExternalUrl } = require('./window');
+
+function createWindow() {
+  const win = new BrowserWindow(windowOptions(path.join(__dirname, 'preload.js')));
+
+  // Open external links in the default browser instead of a new Electron window.
+  win.webContents.setWindowOpenHandler(({ url }) => {
+    if (isExternalUrl(url)) {
+      shell.openExternal(url);
+    }
+    return { action: 'deny' };
+  });
+
+  win.loadFile(path.join(__dirname, 'renderer', 'index.html'));
+}
+
+ipcMain.handle('app:info', () => ({
+  name: app.getName(),
+  version: app.getVersion(),
+  electron: process.versions.electron,
+}));
+
+app.whenReady().then(() => {
+  createWindow();
+
+  // macOS: re-create a window when the dock icon is clicked and no windows are open.
+  app.on('activate', () => {
+    if (BrowserWindow.getAllWindows().length === 0) {
+      createWindow();
+    }
+  });
+});
+
+app.on('window-all-closed', () => {
+  if (process.platform !== 'darwin') {
+    app.quit();
+  }
+});
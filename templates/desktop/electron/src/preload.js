@@ -0,0 +1,7 @@
+const { contextBridge, ipcRenderer } = require('electron');
+
+// Expose a minimal, explicit API to the renderer; it has no access to Node.js.
+contextBridge.exposeInMainWorld('desktop', {
+  platform: process.platform,
+  info: () => ipcRenderer.invoke('app:info'),
+});
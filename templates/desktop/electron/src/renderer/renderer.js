@@ -0,0 +1,10 @@
+// Runs in the sandboxed renderer: only the API exposed by preload.js is available.
+async function showInfo() {
+  const el = document.getElementById('info');
+  const info = await window.desktop.info();
+  el.textContent = `${info.name} v${info.version} · Electron ${info.electron} · ${window.desktop.platform}`;
+}
+
+showInfo().catch((err) => {
+  document.getElementById('info').textContent = `Failed to load app info: ${err.message}`;
+});
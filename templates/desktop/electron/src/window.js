@@ -0,0 +1,36 @@
+// Window settings kept free of Electron imports so they can be unit tested with node --test.
+
+/**
+ * BrowserWindow options with a sandboxed, context-isolated renderer.
+ * @param {string} preload absolute path to the preload script
+ */
+function windowOptions(preload) {
+  return {
+    width: 1024,
+    height: 720,
+    minWidth: 480,
+    minHeight: 360,
+    show: true,
+    webPreferences: {
+      preload,
+      contextIsolation: true,
+      nodeIntegration: false,
+      sandbox: true,
+    },
+  };
+}
+
+/**
+ * Reports whether a URL should be opened in the system browser.
+ * @param {string} url
+ */
+function isExternalUrl(url) {
+  try {
+    const { protocol } = new URL(url);
+    return protocol === 'https:' || protocol === 'http:' || protocol === 'mailto:';
+  } catch {
+    return false;
+  }
+}
+
+module.exports = { windowOptions, isExternalUrl };
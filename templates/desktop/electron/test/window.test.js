@@ -0,0 +1,19 @@
+const test = require('node:test');
+const assert = require('node:assert/strict');
+
+const { windowOptions, isExternalUrl } = require('../src/window');
+
+test('renderer is sandboxed and context isolated', () => {
+  const opts = windowOptions('/tmp/preload.js');
+  assert.equal(opts.webPreferences.preload, '/tmp/preload.js');
+  assert.equal(opts.webPreferences.contextIsolation, true);
+  assert.equal(opts.webPreferences.nodeIntegration, false);
+  assert.equal(opts.webPreferences.sandbox, true);
+});
+
+test('only web and mail links open externally', () => {
+  assert.equal(isExternalUrl('https://example.com'), true);
+  assert.equal(isExternalUrl('mailto:hi@example.com'), true);
+  assert.equal(isExternalUrl('file:///etc/passwd'), false);
+  assert.equal(isExternalUrl('not a url'), false);
+});
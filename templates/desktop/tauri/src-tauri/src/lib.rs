@@ -0,0 +1,38 @@
+/// Builds the greeting returned to the frontend; kept separate from the command for testing.
+fn greeting(name: &str) -> String {
+    let name = name.trim();
+    if name.is_empty() {
+        return "Hello from Rust!".to_string();
+    }
+    format!("Hello, {name}! You've been greeted from Rust!")
+}
+
+/// Called from the frontend with `invoke("greet", { name })` (see src/tauri.ts).
+#[tauri::command]
+fn greet(name: &str) -> String {
+    greeting(name)
+}
+
+#[cfg_attr(mobile, tauri::mobile_entry_point)]
+pub fn run() {
+    tauri::Builder::default()
+        .plugin(tauri_plugin_opener::init())
+        .invoke_handler(tauri::generate_handler![greet])
+        .run(tauri::generate_context!())
+        .expect("error while running tauri application");
+}
+
+#[cfg(test)]
+mod tests {
+    use super::greeting;
+
+    #[test]
+    fn greets_by_name() {
+        assert_eq!(greeting(" Ada "), "Hello, Ada! You've been greeted from Rust!");
+    }
+
+    #[test]
+    fn greets_without_name() {
+        assert_eq!(greeting(""), "Hello from Rust!");
+    }
+}
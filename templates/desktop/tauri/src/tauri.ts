@@ -0,0 +1,6 @@
+import { invoke } from '@tauri-apps/api/core'
+
+// Calls the `greet` command defined in src-tauri/src/lib.rs.
+export function greet(name: string): Promise<string> {
+  return invoke<string>('greet', { name })
+}
@@ -0,0 +1,15 @@
+import type { Signal } from "@preact/signals";
+
+interface CounterProps {
+  count: Signal<number>;
+}
+
+export default function Counter(props: CounterProps) {
+  return (
+    <div class="counter">
+      <button type="button" onClick={() => props.count.value -= 1}>-1</button>
+      <p>{props.count}</p>
+      <button type="button" onClick={() => props.count.value += 1}>+1</button>
+    </div>
+  );
+}
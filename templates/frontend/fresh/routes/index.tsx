@@ -0,0 +1,15 @@
+import { useSignal } from "@preact/signals";
+import Counter from "../islands/Counter.tsx";
+
+export default function Home() {
+  const count = useSignal(3);
+  return (
+    <main class="container">
+      <h1>Welcome to Fresh</h1>
+      <p>
+        Edit <code>./routes/index.tsx</code> and save to reload.
+      </p>
+      <Counter count={count} />
+    </main>
+  );
+}
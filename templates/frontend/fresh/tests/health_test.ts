@@ -0,0 +1,10 @@
+import { assertEquals } from "$std/assert/mod.ts";
+import type { FreshContext } from "$fresh/server.ts";
+import { handler } from "../routes/api/health.ts";
+
+Deno.test("GET /api/health returns ok", async () => {
+  const req = new Request("http://localhost/api/health");
+  const res = await handler.GET!(req, {} as FreshContext);
+  assertEquals(res.status, 200);
+  assertEquals(await res.json(), { status: "ok" });
+});
@@ -0,0 +1,66 @@
+import { useEffect, useState } from "react";
+import { createTodo, deleteTodo, listTodos, updateTodo } from "./api.js";
+
+export default function App() {
+  const [todos, setTodos] = useState([]);
+  const [title, setTitle] = useState("");
+  const [error, setError] = useState(null);
+
+  useEffect(() => {
+    listTodos().then(setTodos).catch((err) => setError(err.message));
+  }, []);
+
+  async function handleSubmit(event) {
+    event.preventDefault();
+    if (!title.trim()) return;
+    try {
+      const todo = await createTodo(title);
+      setTodos([todo, ...todos]);
+      setTitle("");
+    } catch (err) {
+      setError(err.message);
+    }
+  }
+
+  async function toggle(todo) {
+    const updated = await updateTodo(todo._id, !todo.done);
+    setTodos(todos.map((t) => (t._id === updated._id ? updated : t)));
+  }
+
+  async function remove(todo) {
+    await deleteTodo(todo._id);
+    setTodos(todos.filter((t) => t._id !== todo._id));
+  }
+
+  return (
+    <main className="container">
+      <h1>Todos</h1>
+      {error && <p className="error">{error}</p>}
+      <form onSubmit={handleSubmit}>
+        <input
+          value={title}
+          onChange={(e) => setTitle(e.target.value)}
+          placeholder="What needs to be done?"
+        />
+        <button type="submit">Add</button>
+      </form>
+      <ul>
+        {todos.map((todo) => (
+          <li key={todo._id} className={todo.done ? "done" : ""}>
+            <label>
+              <input
+                type="checkbox"
+                checked={todo.done}
+                onChange={() => toggle(todo)}
+              />
+              {todo.title}
+            </label>
+            <button type="button" onClick={() => remove(todo)}>
+              ×
+            </button>
+          </li>
+        ))}
+      </ul>
+    </main>
+  );
+}
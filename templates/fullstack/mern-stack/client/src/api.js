@@ -0,0 +1,20 @@
+async function request(path, options = {}) {
+  const res = await fetch(`/api${path}`, {
+    headers: { "Content-Type": "application/json" },
+    ...options,
+  });
+  if (!res.ok) {
+    throw new Error(`${options.method ?? "GET"} ${path} failed: ${res.status}`);
+  }
+  return res.status === 204 ? null : res.json();
+}
+
+export const listTodos = () => request("/todos");
+
+export const createTodo = (title) =>
+  request("/todos", { method: "POST", body: JSON.stringify({ title }) });
+
+export const updateTodo = (id, done) =>
+  request(`/todos/${id}`, { method: "PATCH", body: JSON.stringify({ done }) });
+
+export const deleteTodo = (id) => request(`/todos/${id}`, { method: "DELETE" });
@@ -0,0 +1,32 @@
+import path from "node:path";
+import { fileURLToPath } from "node:url";
+import cors from "cors";
+import express from "express";
+import todosRouter from "./routes/todos.js";
+
+const clientDist = path.resolve(
+  path.dirname(fileURLToPath(import.meta.url)),
+  "../../client/dist",
+);
+
+export function createApp() {
+  const app = express();
+
+  app.use(cors({ origin: process.env.CLIENT_ORIGIN }));
+  app.use(express.json());
+
+  app.get("/api/health", (req, res) => {
+    res.json({ status: "ok" });
+  });
+  app.use("/api/todos", todosRouter);
+
+  // In production the server also serves the built React app.
+  if (process.env.NODE_ENV === "production") {
+    app.use(express.static(clientDist));
+    app.get("*", (req, res) => {
+      res.sendFile(path.join(clientDist, "index.html"));
+    });
+  }
+
+  return app;
+}
@@ -0,0 +1,51 @@
+import { Router } from "express";
+import Todo from "../models/Todo.js";
+
+const router = Router();
+
+router.get("/", async (req, res, next) => {
+  try {
+    res.json(await Todo.find().sort({ createdAt: -1 }));
+  } catch (err) {
+    next(err);
+  }
+});
+
+router.post("/", async (req, res, next) => {
+  try {
+    const title = req.body?.title?.trim();
+    if (!title) {
+      return res.status(400).json({ error: "title is required" });
+    }
+    res.status(201).json(await Todo.create({ title }));
+  } catch (err) {
+    next(err);
+  }
+});
+
+router.patch("/:id", async (req, res, next) => {
+  try {
+    const todo = await Todo.findByIdAndUpdate(
+      req.params.id,
+      { done: Boolean(req.body?.done) },
+      { new: true },
+    );
+    if (!todo) {
+      return res.status(404).json({ error: "todo not found" });
+    }
+    res.json(todo);
+  } catch (err) {
+    next(err);
+  }
+});
+
+router.delete("/:id", async (req, res, next) => {
+  try {
+    await Todo.findByIdAndDelete(req.params.id);
+    res.status(204).end();
+  } catch (err) {
+    next(err);
+  }
+});
+
+export default router;
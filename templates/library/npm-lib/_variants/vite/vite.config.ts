@@ -0,0 +1,15 @@
+import { defineConfig } from 'vite'
+import dts from 'vite-plugin-dts'
+
+// https://vite.dev/guide/build#library-mode
+export default defineConfig({
+  plugins: [dts({ include: ['src'], exclude: ['src/**/*.test.ts'] })],
+  build: {
+    lib: {
+      entry: 'src/index.ts',
+      formats: ['es', 'cjs'],
+      fileName: (format) => (format === 'es' ? 'index.js' : 'index.cjs'),
+    },
+    sourcemap: true,
+  },
+})
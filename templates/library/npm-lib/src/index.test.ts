@@ -0,0 +1,20 @@
+import { describe, expect, it } from 'vitest'
+import { slugify } from './index'
+
+describe('slugify', () => {
+  it('lowercases and joins words with dashes', () => {
+    expect(slugify('Hello, World!')).toBe('hello-world')
+  })
+
+  it('strips accents', () => {
+    expect(slugify('Crème Brûlée')).toBe('creme-brulee')
+  })
+
+  it('uses a custom separator', () => {
+    expect(slugify('  Many   spaces ', { separator: '_' })).toBe('many_spaces')
+  })
+
+  it('returns an empty string when nothing is left', () => {
+    expect(slugify('!!!')).toBe('')
+  })
+})
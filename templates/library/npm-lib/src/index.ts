@@ -0,0 +1,21 @@
+export interface SlugifyOptions {
+  /** Character placed between words. Defaults to "-". */
+  separator?: string
+}
+
+/**
+ * Converts text into a URL-friendly slug.
+ *
+ * @example
+ * slugify('Hello, World!') // => 'hello-world'
+ */
+export function slugify(input: string, options: SlugifyOptions = {}): string {
+  const separator = options.separator ?? '-'
+  return input
+    .normalize('NFKD')
+    .replace(/[\u0300-\u036f]/g, '') // drop combining accents left by NFKD
+    .toLowerCase()
+    .split(/[^a-z0-9]+/)
+    .filter(Boolean)
+    .join(separator)
+}
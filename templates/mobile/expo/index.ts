@@ -0,0 +1,7 @@
+import { registerRootComponent } from 'expo';
+
+import App from './App';
+
+// registerRootComponent calls AppRegistry.registerComponent('main', () => App)
+// and sets up the environment for both Expo Go and native builds.
+registerRootComponent(App);